@@ -0,0 +1,20 @@
+// gogogoproxy 管理 GitHub 加速代理：拉取公开代理列表、
+// 实测延迟排名，并把结果写进 gogogo 与 git 的配置。
+// 与 gogogo/modtool 共享一个仓库，是设备端工具链的一员。
+package main
+
+import (
+	"os"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gogogoproxy"
+)
+
+// Version 由 -ldflags "-X main.Version=..." 注入
+var Version = "dev"
+
+func main() {
+	gogogoproxy.Version = Version
+	if err := gogogoproxy.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
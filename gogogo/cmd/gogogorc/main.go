@@ -0,0 +1,20 @@
+// gogogorc 在开机时为 shell 生成根模块的环境变量导出语句，
+// 供 /etc/mkshrc 等启动脚本 eval。与 gogogo/modtool/gogogoproxy
+// 共享一个仓库，是设备端工具链的一员。
+package main
+
+import (
+	"os"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gogogorc"
+)
+
+// Version 由 -ldflags "-X main.Version=..." 注入
+var Version = "dev"
+
+func main() {
+	gogogorc.Version = Version
+	if err := gogogorc.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,20 @@
+// modtool 是根模块 (Magisk/KernelSU/APatch) 的打包与发布工具，
+// 与 gogogo 共享一个仓库：gogogo 负责交叉编译，modtool 负责
+// 把产物装进可刷入的模块 zip 并维护发布元数据。
+package main
+
+import (
+	"os"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/modtool"
+)
+
+// Version 由 -ldflags "-X main.Version=..." 注入
+var Version = "dev"
+
+func main() {
+	modtool.Version = Version
+	if err := modtool.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
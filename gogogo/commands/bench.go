@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/config"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+)
+
+var flagBenchRuns int
+
+// benchScenario 一组构建设置，bench 会在各场景下重复计时
+type benchScenario struct {
+	Name string
+	// Parallel 并行度
+	Parallel int
+	// ColdCache 每次运行前换用全新 GOCACHE
+	ColdCache bool
+	// Compress 是否附带压缩阶段
+	Compress bool
+}
+
+// benchRecord 持久化到历史文件的单条结果
+type benchRecord struct {
+	Time     time.Time `json:"time"`
+	Scenario string    `json:"scenario"`
+	Seconds  float64   `json:"seconds"`
+	Runs     int       `json:"runs"`
+}
+
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "⏱️  对比不同设置下的构建耗时，历史记录用于发现构建器自身的回归",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := resolveBuildConfig(); err != nil {
+			return err
+		}
+		scenarios := []benchScenario{
+			{Name: "warm", Parallel: buildCfg.Parallel},
+			{Name: "cold", Parallel: buildCfg.Parallel, ColdCache: true},
+			{Name: "warm-j1", Parallel: 1},
+		}
+		history := loadBenchHistory()
+		fmt.Printf("⏱️  每场景 %d 次，平台: %d 个\n\n", flagBenchRuns, len(buildCfg.Platforms))
+		fmt.Printf("%-10s %10s %10s\n", "场景", "平均(s)", "历史(s)")
+		for _, sc := range scenarios {
+			avg, err := runBenchScenario(sc)
+			if err != nil {
+				return fmt.Errorf("场景 %s 失败: %w", sc.Name, err)
+			}
+			prev := history[sc.Name]
+			prevCol := "-"
+			if prev > 0 {
+				prevCol = fmt.Sprintf("%.1f", prev)
+			}
+			fmt.Printf("%-10s %10.1f %10s\n", sc.Name, avg, prevCol)
+			if err := appendBenchHistory(benchRecord{
+				Time: time.Now(), Scenario: sc.Name, Seconds: avg, Runs: flagBenchRuns,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+// runBenchScenario 在场景设置下重复构建并返回平均耗时 (秒)
+func runBenchScenario(sc benchScenario) (float64, error) {
+	var total time.Duration
+	for i := 0; i < flagBenchRuns; i++ {
+		outDir, err := os.MkdirTemp("", "gogogo-bench-")
+		if err != nil {
+			return 0, err
+		}
+		cfg := *buildCfg
+		cfg.OutputDir = outDir
+		cfg.Parallel = sc.Parallel
+		cfg.Compress = sc.Compress
+		cfg.NonInteractive = true
+		if sc.ColdCache {
+			// 经 Config 显式注入空白 GOCACHE：改进程环境变量
+			// 会被共享缓存的记忆化探测覆盖，冷场景实际在测热缓存
+			cacheDir, err := os.MkdirTemp("", "gogogo-bench-cache-")
+			if err != nil {
+				return 0, err
+			}
+			cfg.GoCache = cacheDir
+		}
+
+		start := time.Now()
+		_, err = gobuild.BuildWithProgress(&cfg)
+		total += time.Since(start)
+		os.RemoveAll(outDir)
+		if cfg.GoCache != "" {
+			os.RemoveAll(cfg.GoCache)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return total.Seconds() / float64(flagBenchRuns), nil
+}
+
+// benchHistoryPath 历史文件与用户配置放在一起
+func benchHistoryPath() (string, error) {
+	cfgPath, err := config.Path()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(cfgPath), "bench_history.jsonl"), nil
+}
+
+// loadBenchHistory 返回每个场景最近一次的耗时
+func loadBenchHistory() map[string]float64 {
+	history := map[string]float64{}
+	path, err := benchHistoryPath()
+	if err != nil {
+		return history
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return history
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	for {
+		var rec benchRecord
+		if err := dec.Decode(&rec); err != nil {
+			break
+		}
+		history[rec.Scenario] = rec.Seconds
+	}
+	return history
+}
+
+// appendBenchHistory 追加一条记录到历史文件
+func appendBenchHistory(rec benchRecord) error {
+	path, err := benchHistoryPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(rec)
+}
+
+func init() {
+	benchCmd.Flags().IntVar(&flagBenchRuns, "runs", 3, "每个场景的重复次数")
+	rootCmd.AddCommand(benchCmd)
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+var (
+	flagBootstrapVersion string
+	flagBootstrapDest    string
+	flagBootstrapForce   bool
+)
+
+var bootstrapCmd = &cobra.Command{
+	Use:   "bootstrap",
+	Short: "📥 下载并安装 Go 工具链 (Android 设备自举)",
+	Long: "在没有 go 命令的设备上下载官方 Go 发行版并解包到模块目录，\n" +
+		"Android 上使用 linux/arm64 发行版 (在 root 环境下可直接运行)。",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := exec.LookPath("go"); err == nil && !flagBootstrapForce {
+			fmt.Println("✅ go 已在 PATH 中，无需自举 (--force 可强制重装)")
+			return nil
+		}
+		dest := flagBootstrapDest
+		if dest == "" {
+			if dir := utils.ModuleDir(); dir != "" {
+				dest = dir
+			} else {
+				home, err := os.UserHomeDir()
+				if err != nil {
+					return err
+				}
+				dest = filepath.Join(home, ".gogogo")
+			}
+		}
+		version := flagBootstrapVersion
+		if version == "" {
+			var err error
+			version, err = latestGoVersion()
+			if err != nil {
+				return err
+			}
+		}
+		goos := runtime.GOOS
+		if goos == "android" {
+			goos = "linux"
+		}
+		url := fmt.Sprintf("https://go.dev/dl/%s.%s-%s.tar.gz", version, goos, runtime.GOARCH)
+		fmt.Printf("📥 下载 %s\n", url)
+		resp, err := http.Get(url)
+		if err != nil {
+			return fmt.Errorf("下载 Go 发行版失败: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("下载 Go 发行版失败: %s (%s)", resp.Status, url)
+		}
+		if err := os.MkdirAll(dest, 0o755); err != nil {
+			return err
+		}
+		if err := gobuild.Untar(resp.Body, dest); err != nil {
+			return fmt.Errorf("解包失败: %w", err)
+		}
+		goroot := filepath.Join(dest, "go")
+		fmt.Printf("✅ 已安装到 %s\n", goroot)
+		fmt.Println("  在 shell 配置中加入:")
+		fmt.Printf("    export GOROOT=%s\n", goroot)
+		fmt.Printf("    export PATH=$PATH:%s\n", filepath.Join(goroot, "bin"))
+		return nil
+	},
+}
+
+// latestGoVersion 从 go.dev 查询最新稳定版 (如 go1.22.4)
+func latestGoVersion() (string, error) {
+	resp, err := http.Get("https://go.dev/VERSION?m=text")
+	if err != nil {
+		return "", fmt.Errorf("查询最新 Go 版本失败: %w", err)
+	}
+	defer resp.Body.Close()
+	buf := make([]byte, 64)
+	n, _ := resp.Body.Read(buf)
+	line, _, _ := strings.Cut(string(buf[:n]), "\n")
+	version := strings.TrimSpace(line)
+	if !strings.HasPrefix(version, "go") {
+		return "", fmt.Errorf("无法解析版本号: %q", version)
+	}
+	return version, nil
+}
+
+func init() {
+	bootstrapCmd.Flags().StringVar(&flagBootstrapVersion, "go-dist", "", "要安装的版本 (如 go1.22.4，默认最新稳定版)")
+	bootstrapCmd.Flags().StringVar(&flagBootstrapDest, "dest", "", "安装目录 (默认模块目录或 ~/.gogogo)")
+	bootstrapCmd.Flags().BoolVar(&flagBootstrapForce, "force", false, "即使 go 已存在也重新安装")
+	rootCmd.AddCommand(bootstrapCmd)
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+)
+
+var (
+	cleanKeepLast     int
+	cleanPruneOlder   string
+	cleanAllArtifacts bool
+)
+
+var cleanCmd = &cobra.Command{
+	Use:   "clean",
+	Short: "🧹 清理输出目录 (日志/断点文件/历史构建)",
+	Long: `清理构建输出。不带参数时只删日志与断点等临时文件；
+--keep-last / --prune-older 按保留策略删除历史构建目录
+(配合 --output-layout versioned 的时间戳输出)；
+--all-artifacts 删除整个输出目录。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := buildCfg.OutputDir
+		if cleanAllArtifacts {
+			if err := os.RemoveAll(dir); err != nil {
+				return err
+			}
+			fmt.Printf("🧹 已删除全部产物: %s\n", dir)
+			return nil
+		}
+		if cleanKeepLast > 0 || cleanPruneOlder != "" {
+			var age time.Duration
+			if cleanPruneOlder != "" {
+				var err error
+				if age, err = gobuild.ParseAge(cleanPruneOlder); err != nil {
+					return err
+				}
+			}
+			removed, err := gobuild.PruneOutputs(dir, cleanKeepLast, age)
+			if err != nil {
+				return err
+			}
+			if removed == 0 {
+				fmt.Println("✅ 没有需要清理的历史构建")
+			} else {
+				fmt.Printf("✅ 共清理 %d 个历史构建目录\n", removed)
+			}
+			return nil
+		}
+		return gobuild.CleanTransient(dir)
+	},
+}
+
+func init() {
+	cleanCmd.Flags().IntVar(&cleanKeepLast, "keep-last", 0, "仅保留最新 N 个历史构建目录")
+	cleanCmd.Flags().StringVar(&cleanPruneOlder, "prune-older", "", "删除早于该期限的历史构建 (如 7d / 48h)")
+	cleanCmd.Flags().BoolVar(&cleanAllArtifacts, "all-artifacts", false, "删除整个输出目录")
+	rootCmd.AddCommand(cleanCmd)
+}
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/config"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "⚙️  管理持久用户配置 (默认平台、输出目录、ndk-path 等)",
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "写入配置项",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := config.Set(args[0], args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("✅ %s = %s\n", args[0], args[1])
+		return nil
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "读取配置项",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		values, err := config.Load()
+		if err != nil {
+			return err
+		}
+		value, ok := values[args[0]]
+		if !ok {
+			return fmt.Errorf("配置项 %q 不存在", args[0])
+		}
+		fmt.Println(value)
+		return nil
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "列出全部配置项",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		values, err := config.Load()
+		if err != nil {
+			return err
+		}
+		path, _ := config.Path()
+		fmt.Printf("📄 %s\n", path)
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Printf("  %s: %s\n", k, values[k])
+		}
+		return nil
+	},
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "删除配置项",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return config.Unset(args[0])
+	},
+}
+
+// applyUserConfig 把持久配置作为 flag 的默认值：
+// 仅对本次命令行里没有显式指定的 flag 生效
+func applyUserConfig(cmd *cobra.Command) error {
+	values, err := config.Load()
+	if err != nil {
+		return err
+	}
+	f := cmd.Root().PersistentFlags()
+	for key, value := range values {
+		if name, ok := strings.CutPrefix(key, "group."); ok {
+			if err := platform.RegisterGroup(name, value); err != nil {
+				return fmt.Errorf("自定义平台组 %s 无效: %w", name, err)
+			}
+			continue
+		}
+		if target, ok := strings.CutPrefix(key, "skip."); ok {
+			if buildCfg.SkipTargets == nil {
+				buildCfg.SkipTargets = map[string]string{}
+			}
+			buildCfg.SkipTargets[target] = value
+			continue
+		}
+		if target, ok := strings.CutPrefix(key, "xfail."); ok {
+			if buildCfg.XFailTargets == nil {
+				buildCfg.XFailTargets = map[string]string{}
+			}
+			buildCfg.XFailTargets[target] = value
+			continue
+		}
+		if flag := f.Lookup(key); flag != nil && !flag.Changed {
+			if err := flag.Value.Set(value); err != nil {
+				return fmt.Errorf("配置项 %s 无效: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
+
+func init() {
+	configCmd.AddCommand(configSetCmd, configGetCmd, configListCmd, configUnsetCmd)
+	rootCmd.AddCommand(configCmd)
+}
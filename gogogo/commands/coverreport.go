@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var flagCoverProfile string
+
+var coverreportCmd = &cobra.Command{
+	Use:   "coverreport <covdata目录>...",
+	Short: "📈 汇总 --cover 二进制产生的覆盖率数据",
+	Long: "合并一个或多个 GOCOVERDIR 目录 (可从设备 adb pull 回来)，\n" +
+		"打印各包覆盖率；--profile 可另存为文本 profile 供 go tool cover -html 使用。",
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, dir := range args {
+			if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+				return fmt.Errorf("覆盖率目录不存在: %s", dir)
+			}
+		}
+		input := strings.Join(args, ",")
+
+		percent := exec.Command("go", "tool", "covdata", "percent", "-i="+input)
+		percent.Stdout = os.Stdout
+		percent.Stderr = os.Stderr
+		if err := percent.Run(); err != nil {
+			return fmt.Errorf("覆盖率汇总失败: %w", err)
+		}
+
+		if flagCoverProfile != "" {
+			textfmt := exec.Command("go", "tool", "covdata", "textfmt",
+				"-i="+input, "-o="+flagCoverProfile)
+			if out, err := textfmt.CombinedOutput(); err != nil {
+				return fmt.Errorf("导出 profile 失败: %w\n%s", err, out)
+			}
+			fmt.Printf("✅ profile 已写入 %s (go tool cover -html=%s 可视化)\n",
+				flagCoverProfile, flagCoverProfile)
+		}
+		return nil
+	},
+}
+
+func init() {
+	coverreportCmd.Flags().StringVar(&flagCoverProfile, "profile", "", "另存文本 profile 的路径")
+	rootCmd.AddCommand(coverreportCmd)
+}
@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/daemon"
+)
+
+var daemonListen string
+
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "🛰️  常驻服务: 通过 REST API 触发与查询构建",
+	Long: `启动 HTTP 守护进程:
+  POST /builds        提交构建 (JSON 配置)
+  GET  /builds        列出全部构建
+  GET  /builds/{id}   查询单个构建状态与结果`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return daemon.New().ListenAndServe(daemonListen)
+	},
+}
+
+func init() {
+	daemonCmd.Flags().StringVar(&daemonListen, "listen", ":7655", "监听地址")
+	rootCmd.AddCommand(daemonCmd)
+}
@@ -0,0 +1,182 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+var (
+	deployADB    bool
+	deploySerial string
+	deployZip    string
+	deployDest   string
+	deployRun    bool
+	deployLogcat bool
+)
+
+var deployCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "📲 把构建产物部署到连接的设备 (adb)",
+	Long: `经 adb 把 android 产物推到设备并设好执行权限；
+--zip 时推送模块 zip 并用 Magisk CLI 安装；
+--run 推完直接执行并回流输出，--logcat 持续跟踪日志。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !deployADB {
+			return fmt.Errorf("目前只支持 adb 部署，请加 --adb")
+		}
+		if _, err := exec.LookPath("adb"); err != nil {
+			return fmt.Errorf("未找到 adb，请安装 platform-tools")
+		}
+		if deployZip != "" {
+			if err := deployModuleZip(deployZip); err != nil {
+				return err
+			}
+		} else {
+			if err := deployBinaries(); err != nil {
+				return err
+			}
+		}
+		if deployLogcat {
+			fmt.Println("📋 跟踪 logcat (Ctrl-C 退出)...")
+			return adbStream("logcat")
+		}
+		return nil
+	},
+}
+
+// adbArgs 组装带 -s 序列号的 adb 参数
+func adbArgs(args ...string) []string {
+	if deploySerial != "" {
+		return append([]string{"-s", deploySerial}, args...)
+	}
+	return args
+}
+
+// adbRun 执行 adb 命令并返回合并输出
+func adbRun(args ...string) (string, error) {
+	out, err := exec.Command("adb", adbArgs(args...)...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("adb %s 失败: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return string(out), nil
+}
+
+// adbStream 执行 adb 命令并把输出直接接到终端
+func adbStream(args ...string) error {
+	cmd := exec.Command("adb", adbArgs(args...)...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// deployModuleZip 推送模块 zip 并经 Magisk CLI 安装
+func deployModuleZip(zipPath string) error {
+	if _, err := os.Stat(zipPath); err != nil {
+		return fmt.Errorf("找不到模块 zip: %s", zipPath)
+	}
+	remote := "/data/local/tmp/" + filepath.Base(zipPath)
+	if _, err := adbRun("push", zipPath, remote); err != nil {
+		return err
+	}
+	fmt.Printf("📤 %s → %s\n", zipPath, remote)
+	out, err := adbRun("shell", "su", "-c", "magisk --install-module "+remote)
+	if err != nil {
+		return fmt.Errorf("模块安装失败 (设备需要 root 且装有 Magisk): %w", err)
+	}
+	fmt.Print(out)
+	fmt.Println("✅ 模块已安装，重启后生效")
+	return nil
+}
+
+// deployBinaries 把输出目录里的 android 二进制推到设备并加执行位
+func deployBinaries() error {
+	androidDir := filepath.Join(buildCfg.OutputDir, "android")
+	entries, err := os.ReadDir(androidDir)
+	if err != nil {
+		return fmt.Errorf("没有 android 产物可部署 (%s)，请先构建 android 目标", androidDir)
+	}
+	abi := detectDeviceABI()
+	if abi != "" {
+		fmt.Printf("📱 设备 ABI: %s\n", abi)
+	}
+	pushed := 0
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		name := buildCfg.Name
+		if label, ok := strings.CutSuffix(e.Name(), "-"+name); ok && abi != "" {
+			// 已知设备 ABI 时只推对应架构 (armv7 等变体标签也要匹配)
+			if want := archForABI(abi); want != "" &&
+				label != want && !strings.HasPrefix(label, want+"v") {
+				continue
+			}
+		}
+		binary := filepath.Join(androidDir, e.Name(), name)
+		if _, err := os.Stat(binary); err != nil {
+			continue
+		}
+		remote := strings.TrimSuffix(deployDest, "/") + "/" + name
+		if _, err := adbRun("push", binary, remote); err != nil {
+			return err
+		}
+		if _, err := adbRun("shell", "chmod", "755", remote); err != nil {
+			return err
+		}
+		fmt.Printf("📤 %s → %s\n", binary, remote)
+		pushed++
+		if deployRun {
+			fmt.Printf("🚀 执行 %s:\n", remote)
+			if err := adbStream("shell", remote); err != nil {
+				return err
+			}
+		}
+	}
+	if pushed == 0 {
+		return fmt.Errorf("在 %s 下没有找到可部署的二进制", androidDir)
+	}
+	utils.Logger.Info("deploy.done", "count", pushed, "dest", deployDest)
+	return nil
+}
+
+// detectDeviceABI 读取设备的主 ABI，失败返回空串
+func detectDeviceABI() string {
+	out, err := adbRun("shell", "getprop", "ro.product.cpu.abi")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(out)
+}
+
+// archForABI 设备 ABI 到 Go 架构前缀的映射
+func archForABI(abi string) string {
+	switch abi {
+	case "arm64-v8a":
+		return "arm64"
+	case "armeabi-v7a":
+		return "arm"
+	case "x86_64":
+		return "amd64"
+	case "x86":
+		return "386"
+	}
+	return ""
+}
+
+func init() {
+	deployCmd.Flags().BoolVar(&deployADB, "adb", false, "经 adb 部署到连接的设备")
+	deployCmd.Flags().StringVar(&deploySerial, "serial", "", "多设备时指定序列号 (adb -s)")
+	deployCmd.Flags().StringVar(&deployZip, "zip", "", "推送并安装模块 zip 而不是裸二进制")
+	deployCmd.Flags().StringVar(&deployDest, "dest", "/data/local/tmp", "二进制在设备上的目标目录")
+	deployCmd.Flags().BoolVar(&deployRun, "run", false, "推送后直接执行并回流输出")
+	deployCmd.Flags().BoolVar(&deployLogcat, "logcat", false, "部署后持续跟踪 logcat")
+	rootCmd.AddCommand(deployCmd)
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+)
+
+var (
+	dockerImage    string
+	dockerTag      string
+	dockerBase     string
+	dockerPush     bool
+	dockerManifest bool
+)
+
+var dockerCmd = &cobra.Command{
+	Use:   "docker",
+	Short: "🐳 构建 linux 目标并打包为最小 OCI 镜像",
+	Long: `先为选定的 linux 平台交叉编译二进制，再将每个产物打包为
+以 scratch (或 distroless) 为基础的最小镜像，按架构打 tag，
+并可选地合并为 multi-arch manifest list。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if _, err := exec.LookPath("docker"); err != nil {
+			return fmt.Errorf("未找到 docker 命令，请先安装 Docker")
+		}
+		if err := resolveBuildConfig(); err != nil {
+			return err
+		}
+		if dockerImage == "" {
+			dockerImage = buildCfg.Name
+		}
+
+		report, err := gobuild.BuildWithProgress(buildCfg)
+		if err != nil {
+			return err
+		}
+
+		var archTags []string
+		for _, res := range report.Results {
+			if res.Err != nil || res.Skipped || res.Platform.OS != "linux" {
+				continue
+			}
+			tag, err := buildImage(res)
+			if err != nil {
+				return err
+			}
+			archTags = append(archTags, tag)
+		}
+		if len(archTags) == 0 {
+			return fmt.Errorf("没有可打包的 linux 产物 (docker 镜像仅支持 linux 目标)")
+		}
+
+		if dockerManifest {
+			if err := createManifest(archTags); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("🐳 镜像构建完成: %d 个架构\n", len(archTags))
+		return nil
+	},
+}
+
+// buildImage 为单个构建产物生成镜像，返回镜像 tag
+func buildImage(res *gobuild.Result) (string, error) {
+	arch := res.Platform.Arch
+	ctxDir := filepath.Join(buildCfg.OutputDir, "docker", arch)
+	if err := os.MkdirAll(ctxDir, 0o755); err != nil {
+		return "", err
+	}
+	binName := filepath.Base(res.OutputPath)
+	data, err := os.ReadFile(res.OutputPath)
+	if err != nil {
+		return "", fmt.Errorf("读取产物失败: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(ctxDir, binName), data, 0o755); err != nil {
+		return "", err
+	}
+
+	dockerfile := fmt.Sprintf("FROM %s\nCOPY %s /%s\nENTRYPOINT [\"/%s\"]\n",
+		dockerBase, binName, binName, binName)
+	if err := os.WriteFile(filepath.Join(ctxDir, "Dockerfile"), []byte(dockerfile), 0o644); err != nil {
+		return "", err
+	}
+
+	tag := fmt.Sprintf("%s:%s-%s", dockerImage, dockerTag, arch)
+	fmt.Printf("🐳 构建镜像 %s (base: %s)\n", tag, dockerBase)
+	build := exec.Command("docker", "build", "--platform", "linux/"+arch, "-t", tag, ctxDir)
+	build.Stdout = os.Stdout
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		return "", fmt.Errorf("docker build 失败 (%s): %w", tag, err)
+	}
+	if dockerPush {
+		push := exec.Command("docker", "push", tag)
+		push.Stdout = os.Stdout
+		push.Stderr = os.Stderr
+		if err := push.Run(); err != nil {
+			return "", fmt.Errorf("docker push 失败 (%s): %w", tag, err)
+		}
+	}
+	return tag, nil
+}
+
+// createManifest 将各架构镜像合并为 manifest list
+func createManifest(archTags []string) error {
+	listTag := fmt.Sprintf("%s:%s", dockerImage, dockerTag)
+	args := append([]string{"manifest", "create", "--amend", listTag}, archTags...)
+	if out, err := exec.Command("docker", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("docker manifest create 失败: %w\n%s", err, out)
+	}
+	fmt.Printf("🐳 已创建 manifest list: %s\n", listTag)
+	if dockerPush {
+		if out, err := exec.Command("docker", "manifest", "push", listTag).CombinedOutput(); err != nil {
+			return fmt.Errorf("docker manifest push 失败: %w\n%s", err, out)
+		}
+	}
+	return nil
+}
+
+func init() {
+	dockerCmd.Flags().StringVar(&dockerImage, "image", "", "镜像名 (默认取二进制名)")
+	dockerCmd.Flags().StringVar(&dockerTag, "tag", "latest", "镜像 tag 前缀")
+	dockerCmd.Flags().StringVar(&dockerBase, "base", "scratch", "基础镜像 (scratch / gcr.io/distroless/static 等)")
+	dockerCmd.Flags().BoolVar(&dockerPush, "push", false, "构建后推送镜像")
+	dockerCmd.Flags().BoolVar(&dockerManifest, "manifest", false, "创建 multi-arch manifest list")
+	rootCmd.AddCommand(dockerCmd)
+}
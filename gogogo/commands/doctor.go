@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// doctorStatus 检查结果等级
+type doctorStatus int
+
+const (
+	doctorPass doctorStatus = iota
+	// doctorWarn 功能受限但不阻塞构建
+	doctorWarn
+	// doctorFail 阻塞性问题，doctor 以非零码退出
+	doctorFail
+)
+
+// doctorCheck 单项环境诊断
+type doctorCheck struct {
+	name string
+	run  func() (doctorStatus, string)
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "🩺 诊断构建环境，输出逐项检查结果与修复建议",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		blockers := 0
+		for _, c := range doctorChecks() {
+			status, detail := c.run()
+			switch status {
+			case doctorPass:
+				fmt.Printf("✅ %-12s %s\n", c.name, detail)
+			case doctorWarn:
+				fmt.Printf("⚠️  %-12s %s\n", c.name, detail)
+			case doctorFail:
+				blockers++
+				fmt.Printf("❌ %-12s %s\n", c.name, detail)
+			}
+		}
+		if blockers > 0 {
+			return fmt.Errorf("发现 %d 个阻塞性问题", blockers)
+		}
+		fmt.Println("🎉 环境检查通过")
+		return nil
+	},
+}
+
+// doctorChecks 返回全部诊断项；顺序即输出顺序
+func doctorChecks() []doctorCheck {
+	checks := []doctorCheck{
+		{"go", func() (doctorStatus, string) {
+			out, err := exec.Command("go", "version").Output()
+			if err != nil {
+				return doctorFail, "未找到 go，请安装 Go 工具链并加入 PATH"
+			}
+			return doctorPass, strings.TrimSpace(string(out))
+		}},
+		{"git", func() (doctorStatus, string) {
+			if _, err := exec.LookPath("git"); err != nil {
+				return doctorWarn, "未找到 git，版本号注入与 release-notes 功能不可用"
+			}
+			return doctorPass, "已安装"
+		}},
+		{"clang", func() (doctorStatus, string) {
+			if cc := utils.FindCompiler("clang"); cc != "" {
+				return doctorPass, cc
+			}
+			return doctorWarn, "未找到 clang，CGO 交叉编译不可用 (可运行 gogogo install-toolchain)"
+		}},
+		{"ndk", func() (doctorStatus, string) {
+			if ndk := utils.FindNDK(); ndk != "" {
+				return doctorPass, ndk
+			}
+			return doctorWarn, "未找到 Android NDK，android + CGO 构建将降级 (设置 ANDROID_NDK_HOME)"
+		}},
+		{"upx", func() (doctorStatus, string) {
+			if _, err := exec.LookPath("upx"); err != nil {
+				return doctorWarn, "未找到 upx，--compress 不可用"
+			}
+			return doctorPass, "已安装"
+		}},
+		{"disk", func() (doctorStatus, string) {
+			free := gobuild.FreeSpaceMB(".")
+			if free < 0 {
+				return doctorWarn, "无法检测可用空间"
+			}
+			if free < 200 {
+				return doctorFail, fmt.Sprintf("当前卷仅剩 %d MB，不足以容纳构建产物", free)
+			}
+			return doctorPass, fmt.Sprintf("可用 %d MB", free)
+		}},
+	}
+	if runtime.GOOS == "darwin" {
+		checks = append(checks, doctorCheck{"xcode", func() (doctorStatus, string) {
+			out, err := exec.Command("xcrun", "--show-sdk-path").Output()
+			if err != nil {
+				return doctorWarn, "xcrun 不可用，ios/darwin CGO 构建受限 (xcode-select --install)"
+			}
+			return doctorPass, strings.TrimSpace(string(out))
+		}})
+	}
+	if runtime.GOOS == "android" {
+		checks = append(checks, doctorCheck{"root", func() (doctorStatus, string) {
+			sol := utils.DetectRootSolution()
+			if sol == nil {
+				return doctorWarn, "未检测到 Magisk/KernelSU/APatch，模块相关功能不可用"
+			}
+			detail := sol.Name + " (" + sol.BaseDir + ")"
+			if sol.Busybox == "" {
+				detail += "，busybox 缺失"
+			}
+			return doctorPass, detail
+		}})
+		checks = append(checks, doctorCheck{"path", func() (doctorStatus, string) {
+			for _, dir := range strings.Split(os.Getenv("PATH"), ":") {
+				if dir == "" {
+					return doctorFail, "PATH 含空目录项，会把当前目录当作查找路径"
+				}
+			}
+			return doctorPass, "PATH 正常"
+		}})
+	}
+	return checks
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
@@ -0,0 +1,47 @@
+package commands
+
+import "errors"
+
+// 退出码约定，CI 据此区分 "部分平台失败" 与 "全军覆没":
+//
+//	0 全部成功 (或 --allow-partial 达标)
+//	1 其他错误
+//	2 部分目标失败
+//	3 所有目标失败
+//	4 配置/参数错误
+const (
+	ExitOK        = 0
+	ExitError     = 1
+	ExitPartial   = 2
+	ExitAllFailed = 3
+	ExitConfig    = 4
+)
+
+// exitCodeError 携带退出码的错误包装
+type exitCodeError struct {
+	err  error
+	code int
+}
+
+func (e exitCodeError) Error() string { return e.err.Error() }
+func (e exitCodeError) Unwrap() error { return e.err }
+
+// withExitCode 给错误附上退出码
+func withExitCode(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+	return exitCodeError{err: err, code: code}
+}
+
+// ExitCode 从错误中取退出码，未标注的错误返回 1
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var ec exitCodeError
+	if errors.As(err, &ec) {
+		return ec.code
+	}
+	return ExitError
+}
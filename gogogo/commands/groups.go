@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/config"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+var groupsCmd = &cobra.Command{
+	Use:   "groups",
+	Short: "🗂️  管理自定义平台组 (可在任何接受组名的位置使用)",
+}
+
+var groupsAddCmd = &cobra.Command{
+	Use:   "add <name> <platforms>",
+	Short: "新增或覆盖自定义组，如 gogogo groups add mygroup linux/amd64,linux/arm64",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, spec := args[0], args[1]
+		if err := platform.RegisterGroup(name, spec); err != nil {
+			return err
+		}
+		if err := config.Set("group."+name, spec); err != nil {
+			return err
+		}
+		fmt.Printf("✅ 组 %s = %s\n", name, spec)
+		return nil
+	},
+}
+
+var groupsRmCmd = &cobra.Command{
+	Use:   "rm <name>",
+	Short: "删除自定义组",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		values, err := config.Load()
+		if err != nil {
+			return err
+		}
+		if _, ok := values["group."+args[0]]; !ok {
+			return fmt.Errorf("自定义组 %q 不存在", args[0])
+		}
+		return config.Unset("group." + args[0])
+	},
+}
+
+var groupsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "列出自定义组与预定义组",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		values, err := config.Load()
+		if err != nil {
+			return err
+		}
+		var custom []string
+		for key := range values {
+			if name, ok := strings.CutPrefix(key, "group."); ok {
+				custom = append(custom, name)
+			}
+		}
+		sort.Strings(custom)
+		if len(custom) > 0 {
+			fmt.Println("自定义组:")
+			for _, name := range custom {
+				fmt.Printf("  %-12s %s\n", name, values["group."+name])
+			}
+		}
+		builtin := make([]string, 0, len(platform.PlatformGroups))
+		for name := range platform.PlatformGroups {
+			builtin = append(builtin, name)
+		}
+		sort.Strings(builtin)
+		fmt.Println("预定义组:")
+		for _, name := range builtin {
+			fmt.Printf("  %-12s %s\n", name, strings.Join(platform.PlatformGroups[name], ","))
+		}
+		return nil
+	},
+}
+
+var groupsInfoCmd = &cobra.Command{
+	Use:   "info <name>",
+	Short: "显示组的成员与元数据",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		values, err := config.Load()
+		if err != nil {
+			return err
+		}
+		if spec, ok := values["group."+name]; ok {
+			fmt.Printf("%s (自定义组)\n", name)
+			fmt.Printf("  成员: %s\n", spec)
+			return nil
+		}
+		members, ok := platform.PlatformGroups[name]
+		if !ok {
+			return fmt.Errorf("平台组 %q 不存在", name)
+		}
+		fmt.Println(name)
+		if meta, ok := platform.GroupMeta[name]; ok {
+			fmt.Printf("  说明: %s\n", meta.Description)
+			fmt.Printf("  分类: %s\n", meta.Category)
+			fmt.Printf("  标签: %s\n", strings.Join(meta.Tags, ", "))
+		}
+		fmt.Printf("  成员 (%d):\n", len(members))
+		for _, m := range members {
+			fmt.Printf("    %s\n", m)
+		}
+		return nil
+	},
+}
+
+var groupsSearchCmd = &cobra.Command{
+	Use:   "search <tag>",
+	Short: "按标签/关键字搜索平台组",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := strings.ToLower(args[0])
+		var hits []string
+		for name, meta := range platform.GroupMeta {
+			if groupMatches(name, meta, query) {
+				hits = append(hits, name)
+			}
+		}
+		if len(hits) == 0 {
+			return fmt.Errorf("没有匹配 %q 的平台组", args[0])
+		}
+		sort.Strings(hits)
+		for _, name := range hits {
+			fmt.Printf("  %-12s %s\n", name, platform.GroupMeta[name].Description)
+		}
+		return nil
+	},
+}
+
+// groupMatches 组名、分类、标签、描述任一命中即算匹配
+func groupMatches(name string, meta platform.GroupInfo, query string) bool {
+	if strings.Contains(strings.ToLower(name), query) ||
+		strings.Contains(strings.ToLower(meta.Category), query) ||
+		strings.Contains(strings.ToLower(meta.Description), query) {
+		return true
+	}
+	for _, tag := range meta.Tags {
+		if strings.Contains(strings.ToLower(tag), query) {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	groupsCmd.AddCommand(groupsAddCmd, groupsRmCmd, groupsListCmd, groupsInfoCmd, groupsSearchCmd)
+	rootCmd.AddCommand(groupsCmd)
+}
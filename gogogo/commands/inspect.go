@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"debug/buildinfo"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+)
+
+var inspectJSON bool
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect <binary>",
+	Short: "🔎 查看产物的 Go buildinfo 与工具链环境快照",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		binary := args[0]
+		info, err := buildinfo.ReadFile(binary)
+		if err != nil {
+			return fmt.Errorf("读取 buildinfo 失败 (不是 Go 二进制?): %w", err)
+		}
+		snap := readEnvSnapshot(binary)
+		if inspectJSON {
+			out := map[string]any{
+				"go_version": info.GoVersion,
+				"path":       info.Path,
+				"main":       info.Main,
+				"deps":       info.Deps,
+				"settings":   info.Settings,
+			}
+			if snap != nil {
+				out["snapshot"] = snap
+			}
+			data, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(data))
+			return nil
+		}
+		printBuildInfo(binary, info)
+		if snap != nil {
+			printEnvSnapshot(snap)
+		}
+		return nil
+	},
+}
+
+// printBuildInfo 友好打印二进制内嵌的 Go buildinfo
+func printBuildInfo(binary string, info *buildinfo.BuildInfo) {
+	fmt.Printf("📦 %s\n", binary)
+	fmt.Printf("  Go 版本:    %s\n", info.GoVersion)
+	fmt.Printf("  主模块:     %s %s\n", info.Main.Path, info.Main.Version)
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "GOOS", "GOARCH", "CGO_ENABLED", "-tags", "-ldflags", "-buildmode",
+			"vcs", "vcs.revision", "vcs.time", "vcs.modified":
+			fmt.Printf("  %-12s %s\n", s.Key+":", s.Value)
+		}
+	}
+	if len(info.Deps) > 0 {
+		fmt.Printf("  依赖 (%d):\n", len(info.Deps))
+		for _, dep := range info.Deps {
+			fmt.Printf("    %s %s\n", dep.Path, dep.Version)
+		}
+	}
+}
+
+// readEnvSnapshot 读取产物旁的 .buildenv.json，不存在返回 nil
+func readEnvSnapshot(binary string) *gobuild.EnvSnapshot {
+	data, err := os.ReadFile(gobuild.SnapshotPath(binary))
+	if err != nil {
+		return nil
+	}
+	var snap gobuild.EnvSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil
+	}
+	return &snap
+}
+
+// printEnvSnapshot 友好打印工具链环境快照
+func printEnvSnapshot(snap *gobuild.EnvSnapshot) {
+	fmt.Println("🧰 构建环境快照:")
+	fmt.Printf("  目标平台:   %s\n", snap.Target)
+	fmt.Printf("  gogogo:     %s\n", snap.Gogogo)
+	fmt.Printf("  Go 工具链:  %s\n", snap.GoVersion)
+	if snap.CCVersion != "" {
+		fmt.Printf("  C 编译器:   %s\n", snap.CCVersion)
+	}
+	if snap.NDKVersion != "" {
+		fmt.Printf("  NDK:        %s (API %d)\n", snap.NDKVersion, snap.AndroidAPI)
+	}
+	fmt.Printf("  构建时间:   %s\n", snap.BuiltAt.Format("2006-01-02 15:04:05"))
+	for _, key := range []string{"CC", "CXX", "CGO_CFLAGS", "CGO_LDFLAGS"} {
+		if v, ok := snap.CGOEnv[key]; ok {
+			fmt.Printf("  %-12s %s\n", key+":", v)
+		}
+	}
+	for _, kv := range snap.Overrides {
+		fmt.Printf("  env %s\n", kv)
+	}
+}
+
+func init() {
+	inspectCmd.Flags().BoolVar(&inspectJSON, "json", false, "以 JSON 输出")
+	rootCmd.AddCommand(inspectCmd)
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+	"github.com/spf13/cobra"
+)
+
+var installToolchainYes bool
+
+var installToolchainCmd = &cobra.Command{
+	Use:       "install-toolchain <工具>",
+	Short:     "🔧 用系统包管理器安装编译工具链",
+	ValidArgs: []string{"clang"},
+	Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	Long: `探测系统包管理器 (scoop/winget/choco/brew/apt/dnf/pacman)
+并安装 CGO 交叉编译需要的工具链，目前支持 clang。
+--yes 跳过确认，供 CI 与脚本使用。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tool := args[0]
+		if installToolchainYes {
+			utils.AssumeYes = true
+		}
+		if path, err := exec.LookPath(tool); err == nil {
+			fmt.Printf("✅ %s 已安装: %s\n", tool, path)
+			return nil
+		}
+		mgr, err := utils.DetectPackageManager()
+		if err != nil {
+			return err
+		}
+		pkg := mgr.ToolchainPackage(tool)
+		if !utils.Confirm("install."+tool,
+			fmt.Sprintf("用 %s 安装 %s (包名 %s)?", mgr.Name, tool, pkg)) {
+			return fmt.Errorf("已取消安装 %s", tool)
+		}
+		if err := mgr.InstallPackage(pkg); err != nil {
+			return err
+		}
+		if _, err := exec.LookPath(tool); err != nil {
+			fmt.Printf("⚠️  安装完成但 PATH 里找不到 %s，可能需要重开终端\n", tool)
+			return nil
+		}
+		fmt.Printf("✅ %s 安装完成\n", tool)
+		return nil
+	},
+}
+
+func init() {
+	installToolchainCmd.Flags().BoolVarP(&installToolchainYes, "yes", "y", false, "跳过确认，直接安装")
+	rootCmd.AddCommand(installToolchainCmd)
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+)
+
+// legacyFlagAliases 旧版散装实现 (MyModule/src/gogogo 与 src/gogogo)
+// 的 flag 拼法到现行 flag 的直接映射。三份实现合并后这里兜住
+// 老脚本，翻译时向标准错误提示新写法
+var legacyFlagAliases = map[string]string{
+	"--out":  "--output",
+	"--jobs": "--parallel",
+	"--bin":  "--name",
+}
+
+// normalizeLegacyArgs 把旧版 flag 翻译成现行写法。
+// --os/--arch 是一对：旧版用两个 flag 指定单一目标，
+// 现行用 --platforms os/arch，缺省的一半取宿主值
+func normalizeLegacyArgs(args []string) []string {
+	var out []string
+	legacyOS, legacyArch := "", ""
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, inline, hasInline := strings.Cut(arg, "=")
+		takeValue := func() (string, bool) {
+			if hasInline {
+				return inline, true
+			}
+			if i+1 < len(args) {
+				i++
+				return args[i], true
+			}
+			return "", false
+		}
+		if alias, ok := legacyFlagAliases[name]; ok {
+			fmt.Fprintf(os.Stderr, "⚠️  %s 已废弃，请改用 %s\n", name, alias)
+			if hasInline {
+				out = append(out, alias+"="+inline)
+			} else {
+				out = append(out, alias)
+			}
+			continue
+		}
+		switch name {
+		case "--os":
+			if v, ok := takeValue(); ok {
+				legacyOS = v
+				continue
+			}
+		case "--arch":
+			if v, ok := takeValue(); ok {
+				legacyArch = v
+				continue
+			}
+		}
+		out = append(out, arg)
+	}
+	if legacyOS != "" || legacyArch != "" {
+		if legacyOS == "" {
+			legacyOS = runtime.GOOS
+		}
+		if legacyArch == "" {
+			legacyArch = runtime.GOARCH
+		}
+		platform := legacyOS + "/" + legacyArch
+		fmt.Fprintf(os.Stderr, "⚠️  --os/--arch 已废弃，请改用 --platforms %s\n", platform)
+		out = append(out, "--platforms", platform)
+	}
+	return out
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+)
+
+var (
+	packageFormats []string
+	packageMeta    gobuild.PackageMeta
+)
+
+var packageCmd = &cobra.Command{
+	Use:   "package",
+	Short: "📦 把 linux 产物打成 deb/rpm/apk 安装包 (经 nfpm)",
+	Long: `扫描输出目录下已构建的 linux 二进制，逐架构生成
+deb/rpm/apk 安装包。需要 PATH 上有 nfpm。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if buildCfg.Name == "" {
+			dir, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			buildCfg.Name = filepath.Base(dir)
+		}
+		var formats []string
+		for _, f := range packageFormats {
+			formats = append(formats, strings.TrimSpace(f))
+		}
+		return gobuild.PackageArtifacts(buildCfg, formats, packageMeta)
+	},
+}
+
+func init() {
+	f := packageCmd.Flags()
+	f.StringSliceVar(&packageFormats, "format", []string{"deb"}, "包格式，逗号分隔 (deb/rpm/apk)")
+	f.StringVar(&packageMeta.Name, "pkg-name", "", "包名 (默认同二进制名)")
+	f.StringVar(&packageMeta.Version, "pkg-version", "", "包版本 (默认 git describe)")
+	f.StringVar(&packageMeta.Maintainer, "maintainer", "", "维护者 (如 \"Name <mail>\")")
+	f.StringVar(&packageMeta.Description, "description", "", "包描述")
+	f.StringVar(&packageMeta.License, "license", "", "许可证标识")
+	f.StringVar(&packageMeta.Homepage, "homepage", "", "主页 URL")
+	f.StringVar(&packageMeta.SystemdUnit, "systemd-unit", "", "随包安装的 systemd unit 文件")
+	rootCmd.AddCommand(packageCmd)
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/config"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// 向导回答以 preset.<名>.<项> 的形式存进用户配置文件，
+// 之后 gogogo --preset <名> 免问答直接复用
+
+var flagPreset string
+
+// offerSavePreset 询问是否把本次回答存为预设
+func offerSavePreset(a *wizardAnswers) error {
+	if !utils.Confirm("wizard.save", "把这些回答保存为预设?") {
+		return nil
+	}
+	name := utils.Input("wizard.preset_name", "预设名称", "default")
+	if name == "" || strings.ContainsAny(name, ". ") {
+		return fmt.Errorf("无效的预设名称: %q", name)
+	}
+	prefix := "preset." + name + "."
+	entries := map[string]string{
+		prefix + "source":    a.Source,
+		prefix + "name":      a.Name,
+		prefix + "platforms": a.Platforms,
+		prefix + "profile":   a.Profile,
+		prefix + "cgo":       strconv.FormatBool(a.CGO),
+		prefix + "compress":  strconv.FormatBool(a.Compress),
+	}
+	for key, value := range entries {
+		if err := config.Set(key, value); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("✅ 已保存预设 %s (之后可用 gogogo --preset %s)\n", name, name)
+	return nil
+}
+
+// loadPreset 读取预设并还原成向导回答
+func loadPreset(name string) (*wizardAnswers, error) {
+	values, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	prefix := "preset." + name + "."
+	get := func(item string) (string, bool) {
+		v, ok := values[prefix+item]
+		return v, ok
+	}
+	source, ok := get("source")
+	if !ok {
+		return nil, fmt.Errorf("预设 %s 不存在 (配置里没有 %ssource)", name, prefix)
+	}
+	a := &wizardAnswers{Source: source}
+	a.Name, _ = get("name")
+	a.Platforms, _ = get("platforms")
+	a.Profile, _ = get("profile")
+	if v, ok := get("cgo"); ok {
+		a.CGO = v == "true"
+	}
+	if v, ok := get("compress"); ok {
+		a.Compress = v == "true"
+	}
+	return a, nil
+}
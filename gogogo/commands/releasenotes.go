@@ -0,0 +1,180 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	releaseNotesFrom   string
+	releaseNotesOutput string
+	releaseNotesAttach bool
+)
+
+// noteSections 约定式提交类型到章节标题的映射，按展示顺序排列
+var noteSections = []struct {
+	types []string
+	title string
+}{
+	{[]string{"feat"}, "✨ 新功能"},
+	{[]string{"fix"}, "🐛 修复"},
+	{[]string{"perf"}, "⚡ 性能"},
+	{[]string{"refactor"}, "♻️ 重构"},
+	{[]string{"docs"}, "📝 文档"},
+	{[]string{"test", "build", "ci", "chore"}, "🔧 维护"},
+}
+
+var releaseNotesCmd = &cobra.Command{
+	Use:   "release-notes [tag]",
+	Short: "📋 从 git 历史生成发布说明 (识别约定式提交)",
+	Long: `收集上一个标签到目标标签之间的提交，按约定式提交类型
+(feat/fix/perf/...) 分组生成 Markdown 发布说明；
+--attach 时经 gh CLI 写入对应的 GitHub Release。`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tag := ""
+		if len(args) > 0 {
+			tag = args[0]
+		} else {
+			out, err := exec.Command("git", "describe", "--tags", "--abbrev=0").Output()
+			if err != nil {
+				return fmt.Errorf("仓库中没有标签，请指定目标标签: %w", err)
+			}
+			tag = strings.TrimSpace(string(out))
+		}
+		from := releaseNotesFrom
+		if from == "" {
+			out, err := exec.Command("git", "describe", "--tags", "--abbrev=0", tag+"^").Output()
+			if err == nil {
+				from = strings.TrimSpace(string(out))
+			}
+		}
+		notes, err := generateReleaseNotes(from, tag)
+		if err != nil {
+			return err
+		}
+		if releaseNotesOutput != "" {
+			if err := os.WriteFile(releaseNotesOutput, []byte(notes), 0o644); err != nil {
+				return err
+			}
+			fmt.Printf("✅ 发布说明已写入 %s\n", releaseNotesOutput)
+		} else {
+			fmt.Print(notes)
+		}
+		if releaseNotesAttach {
+			return attachReleaseNotes(tag, notes)
+		}
+		return nil
+	},
+}
+
+// generateReleaseNotes 生成 from..tag 区间的 Markdown 发布说明
+func generateReleaseNotes(from, tag string) (string, error) {
+	rangeSpec := tag
+	if from != "" {
+		rangeSpec = from + ".." + tag
+	}
+	out, err := exec.Command("git", "log", "--no-merges", "--pretty=format:%s (%h)", rangeSpec).Output()
+	if err != nil {
+		return "", fmt.Errorf("读取 git 历史失败: %w", err)
+	}
+	grouped := map[string][]string{}
+	var breaking, other []string
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		typ, subject, isBreaking := parseConventional(line)
+		if isBreaking {
+			breaking = append(breaking, subject)
+			continue
+		}
+		if typ == "" {
+			other = append(other, subject)
+			continue
+		}
+		grouped[typ] = append(grouped[typ], subject)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n", tag)
+	if from != "" {
+		fmt.Fprintf(&b, "\n变更范围: %s..%s\n", from, tag)
+	}
+	writeSection := func(title string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n### %s\n\n", title)
+		for _, item := range items {
+			fmt.Fprintf(&b, "- %s\n", item)
+		}
+	}
+	writeSection("💥 破坏性变更", breaking)
+	for _, sec := range noteSections {
+		var items []string
+		for _, t := range sec.types {
+			items = append(items, grouped[t]...)
+		}
+		writeSection(sec.title, items)
+	}
+	writeSection("其他", other)
+	return b.String(), nil
+}
+
+// parseConventional 解析约定式提交标题，返回类型、展示文本与是否破坏性变更。
+// 非约定式提交返回空类型
+func parseConventional(subject string) (typ, text string, breaking bool) {
+	head, rest, ok := strings.Cut(subject, ": ")
+	if !ok {
+		return "", subject, false
+	}
+	if strings.HasSuffix(head, "!") {
+		breaking = true
+		head = strings.TrimSuffix(head, "!")
+	}
+	// 去掉 scope: feat(build) → feat
+	if i := strings.IndexByte(head, '('); i >= 0 {
+		if !strings.HasSuffix(head, ")") {
+			return "", subject, false
+		}
+		head = head[:i]
+	}
+	for _, sec := range noteSections {
+		for _, t := range sec.types {
+			if head == t {
+				return t, rest, breaking
+			}
+		}
+	}
+	if breaking {
+		return "", rest, true
+	}
+	return "", subject, false
+}
+
+// attachReleaseNotes 经 gh CLI 把说明写进对应的 GitHub Release
+func attachReleaseNotes(tag, notes string) error {
+	if _, err := exec.LookPath("gh"); err != nil {
+		return fmt.Errorf("未找到 gh CLI，无法附加到 GitHub Release")
+	}
+	cmd := exec.Command("gh", "release", "edit", tag, "--notes-file", "-")
+	cmd.Stdin = strings.NewReader(notes)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("写入 GitHub Release 失败: %v\n%s", err, out)
+	}
+	fmt.Printf("✅ 发布说明已附加到 Release %s\n", tag)
+	return nil
+}
+
+func init() {
+	releaseNotesCmd.Flags().StringVar(&releaseNotesFrom, "from", "", "起始标签 (默认上一个标签)")
+	releaseNotesCmd.Flags().StringVar(&releaseNotesOutput, "file", "", "写入文件而不是标准输出")
+	releaseNotesCmd.Flags().BoolVar(&releaseNotesAttach, "attach", false, "经 gh CLI 附加到对应的 GitHub Release")
+	rootCmd.AddCommand(releaseNotesCmd)
+}
@@ -0,0 +1,307 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/i18n"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// Version 由 main 注入
+var Version = "dev"
+
+var buildCfg = &gobuild.Config{}
+
+var (
+	flagSources      []string
+	flagPlatforms    string
+	flagVerbose      int
+	flagRetry        int
+	flagRetryBackoff time.Duration
+	flagLogFile      string
+	flagLogFormat    string
+	flagLang         string
+	flagRemote       string
+	flagFarm         []string
+	flagProfile      string
+	flagAlgebra      platform.Algebra
+	flagSkipValidate bool
+	flagPorts        string
+	flagDryRun       string
+	flagAllowPartial float64
+	flagFeasibleOnly bool
+
+	closeLogFile func()
+)
+
+var rootCmd = &cobra.Command{
+	Use:     "gogogo",
+	Short:   "🚀 Go 交叉编译工具 - 一条命令构建全平台二进制",
+	Version: Version,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if flagPreset != "" {
+			a, err := loadPreset(flagPreset)
+			if err != nil {
+				return withExitCode(err, ExitConfig)
+			}
+			a.apply()
+		}
+		if err := resolveBuildConfig(); err != nil {
+			return withExitCode(err, ExitConfig)
+		}
+		if flagDryRun != "" {
+			return gobuild.PrintPlan(buildCfg, flagDryRun)
+		}
+		if flagRemote != "" {
+			return gobuild.BuildRemote(buildCfg, flagRemote, gobuild.StripRemoteFlag(os.Args[1:]))
+		}
+		if len(flagFarm) > 0 {
+			return gobuild.BuildFarm(buildCfg, flagFarm)
+		}
+		report, err := gobuild.BuildWithProgress(buildCfg)
+		return buildExitError(report, err)
+	},
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := applyUserConfig(cmd); err != nil {
+			return err
+		}
+		i18n.SetLang(flagLang)
+		if flagLogFile == "" {
+			return nil
+		}
+		var err error
+		closeLogFile, err = utils.SetupLogFile(flagLogFile, flagLogFormat)
+		return err
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		if closeLogFile != nil {
+			closeLogFile()
+		}
+	},
+}
+
+// buildExitError 把构建结果折算成带退出码的错误：
+// 全失败 3，部分失败 2；--allow-partial 达标时整体视为成功
+func buildExitError(report *gobuild.Report, err error) error {
+	if err == nil {
+		return nil
+	}
+	if report == nil || len(report.Results) == 0 {
+		return err
+	}
+	failed := report.Failed()
+	if failed == 0 {
+		return err
+	}
+	succeeded := report.Succeeded()
+	if flagAllowPartial >= 0 {
+		pct := float64(succeeded) / float64(len(report.Results)) * 100
+		if pct >= flagAllowPartial {
+			fmt.Printf("⚠️  %d 个目标失败，但成功率 %.0f%% 达到 --allow-partial 阈值\n", failed, pct)
+			return nil
+		}
+	}
+	if succeeded == 0 {
+		return withExitCode(err, ExitAllFailed)
+	}
+	return withExitCode(err, ExitPartial)
+}
+
+// expandSourcePatterns 展开 -s 中的 ./... 通配模式为具体主包列表
+func expandSourcePatterns(patterns []string) ([]string, error) {
+	var sources []string
+	for _, pat := range patterns {
+		if !strings.Contains(pat, "...") {
+			sources = append(sources, pat)
+			continue
+		}
+		out, err := exec.Command("go", "list",
+			"-f", `{{if eq .Name "main"}}{{.ImportPath}}{{end}}`, pat).Output()
+		if err != nil {
+			return nil, fmt.Errorf("展开包模式 %q 失败: %w", pat, err)
+		}
+		for _, line := range strings.Fields(string(out)) {
+			sources = append(sources, line)
+		}
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("-s 未匹配到任何主包")
+	}
+	return sources, nil
+}
+
+// resolveBuildConfig 补全默认值并展开平台列表
+func resolveBuildConfig() error {
+	sources, err := expandSourcePatterns(flagSources)
+	if err != nil {
+		return err
+	}
+	buildCfg.Source = sources[0]
+	if len(sources) > 1 {
+		buildCfg.Sources = sources
+	}
+	if buildCfg.Name == "" {
+		dir, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		buildCfg.Name = filepath.Base(dir)
+	}
+	platforms, err := platform.Parse(flagPlatforms)
+	if err != nil {
+		return err
+	}
+	platforms, err = flagAlgebra.Apply(platforms)
+	if err != nil {
+		return err
+	}
+	if len(platforms) == 0 {
+		return fmt.Errorf(i18n.T("platform.empty"))
+	}
+	if !flagSkipValidate {
+		if err := platform.Validate(platforms); err != nil {
+			return err
+		}
+	}
+	switch flagPorts {
+	case "all", "first-class", "secondary":
+	default:
+		// FilterByTier 对未知取值不过滤，拼错时会静默放行全部目标
+		return fmt.Errorf("无效的 --ports 取值 %q，可选: first-class/secondary/all", flagPorts)
+	}
+	if flagPorts != "all" {
+		kept, dropped := platform.FilterByTier(platforms, flagPorts)
+		for _, p := range dropped {
+			fmt.Printf("⏭️  跳过 %s (%s 端口)\n", p, p.Tier())
+		}
+		platforms = kept
+		if len(platforms) == 0 {
+			return fmt.Errorf(i18n.T("platform.empty"))
+		}
+	}
+	// 远程执行时能力归执行方，不做本机预筛
+	if flagFeasibleOnly && flagRemote == "" {
+		kept, dropped := gobuild.FilterFeasible(buildCfg, platforms)
+		if len(dropped) > 0 {
+			fmt.Printf("🔍 按宿主能力预筛，剔除 %d 个目标:\n", len(dropped))
+			for _, line := range dropped {
+				fmt.Printf("  ⏭️  %s\n", line)
+			}
+		}
+		platforms = kept
+		if len(platforms) == 0 {
+			return fmt.Errorf(i18n.T("platform.empty"))
+		}
+	}
+	for _, p := range platforms {
+		if p.Tier() == platform.TierBroken {
+			fmt.Printf("⚠️  %s 被上游标记为损坏端口\n", p)
+		} else if flagVerbose >= 1 && p.Tier() == platform.TierSecondary {
+			fmt.Printf("⚠️  %s 是二级支持端口\n", p)
+		}
+	}
+	buildCfg.Platforms = platforms
+	buildCfg.Verbosity = flagVerbose
+	buildCfg.ToolVersion = Version
+	if buildCfg.Parallel <= 0 {
+		buildCfg.Parallel = gobuild.DefaultParallel()
+	}
+	if err := gobuild.ApplyProfile(buildCfg, flagProfile); err != nil {
+		return err
+	}
+	buildCfg.Retry = gobuild.RetryPolicy{
+		MaxAttempts: flagRetry + 1,
+		Backoff:     flagRetryBackoff,
+		MaxBackoff:  gobuild.DefaultRetryPolicy.MaxBackoff,
+	}
+	return gobuild.ApplyOutputLayout(buildCfg)
+}
+
+func init() {
+	f := rootCmd.PersistentFlags()
+	f.StringArrayVarP(&flagSources, "source", "s", []string{"."}, "要编译的主包路径 (可多次或使用 ./... 模式)")
+	f.StringVarP(&buildCfg.OutputDir, "output", "o", "build", "输出目录")
+	f.StringVar(&buildCfg.OutputLayout, "output-layout", "flat", "输出布局: flat 或 versioned (按版本分目录并维护 latest 链接)")
+	f.StringVar(&buildCfg.ArtifactLayout, "artifact-layout", "nested", "产物目录口径: nested (<os>/<arch>-<名>/) 或 flat (平铺为 <名>-<os>-<arch>)")
+	f.StringVar(&buildCfg.Version, "build-version", "", "versioned 布局使用的版本号 (默认 git describe)")
+	f.StringVarP(&buildCfg.Name, "name", "n", "", "二进制名称 (默认取目录名)")
+	f.StringVarP(&flagPlatforms, "platforms", "p", runtime.GOOS+"/"+runtime.GOARCH, "目标平台或平台组，逗号分隔")
+	f.StringVar(&buildCfg.Ldflags, "ldflags", "", "传递给 go build 的 -ldflags")
+	f.StringVar(&buildCfg.Tags, "tags", "", "传递给 go build 的 -tags")
+	f.StringSliceVar(&buildCfg.TagsMatrix, "tags-matrix", nil, "标签矩阵: 每个平台按每个标签集各构建一次")
+	f.StringVar(&flagAlgebra.Include, "include", "", "在 -p 基础上并入的平台/组")
+	f.StringVar(&flagAlgebra.Exclude, "exclude", "", "从结果中移除的平台/组")
+	f.StringVar(&flagAlgebra.Only, "only", "", "仅保留与该列表的交集")
+	f.StringVar(&flagAlgebra.Except, "except", "", "最后再移除的平台/组 (在 --include 之后生效)")
+	f.BoolVar(&flagSkipValidate, "skip-validate", false, "跳过 go tool dist list 平台校验 (自定义工具链用)")
+	f.StringVar(&flagPorts, "ports", "all", "按支持等级过滤目标 (first-class/secondary/all)")
+	f.BoolVar(&flagFeasibleOnly, "feasible-only", false, "预先剔除本机确定无法构建的目标 (如缺 NDK 的 android CGO)")
+	f.StringVar(&flagDryRun, "dry-run", "", "只打印构建计划不执行 (table/json)")
+	f.Lookup("dry-run").NoOptDefVal = "table"
+	f.StringVar(&buildCfg.GoFlags, "go-flags", "", "透传给 go build 的额外 flag (GOFLAGS 亦被继承)")
+	f.StringVar(&flagProfile, "profile", "", "构建预设 (release/debug 或自定义)")
+	rootCmd.Flags().StringVar(&flagPreset, "preset", "", "使用向导保存的回答预设 (覆盖 -s/-p/-n 等对应项)")
+	f.StringVar(&buildCfg.GoVersion, "go-version", "", "指定 Go 工具链版本 (如 1.22.4，经 GOTOOLCHAIN)")
+	f.IntVarP(&buildCfg.Parallel, "parallel", "j", 0, "并行构建数 (默认按核数与可用内存自动推导)")
+	f.IntVar(&buildCfg.MinParallel, "min-parallel", 0, "动态伸缩的并发下限 (默认 1)")
+	f.IntVar(&buildCfg.MaxParallel, "max-parallel", 0, "动态伸缩的并发上限 (默认同 --parallel)")
+	f.BoolVar(&buildCfg.LowPriority, "low-priority", false, "用 nice/ionice 降低编译进程优先级")
+	f.StringVar(&buildCfg.CgroupMemory, "cgroup-memory", "", "配合 --low-priority 的 cgroup 内存上限 (如 2G，仅 linux)")
+	f.IntVar(&flagRetry, "retry", 0, "临时性错误的重试次数")
+	f.DurationVar(&flagRetryBackoff, "retry-backoff", 2*time.Second, "首次重试退避时间，之后指数增长")
+	f.BoolVar(&buildCfg.FailFast, "fail-fast", false, "任一目标失败后取消剩余目标")
+	f.StringArrayVar(&buildCfg.PreHooks, "pre-hook", nil, "每个目标构建前执行的命令 (可多次)")
+	f.StringArrayVar(&buildCfg.PostHooks, "post-hook", nil, "每个目标构建后执行的命令 (可多次)")
+	f.StringVar(&buildCfg.PluginsDir, "plugins-dir", "gogogo.plugins", "产物后处理插件目录")
+	f.StringVar(&buildCfg.Sign, "sign", "", "产物签名方式 (gpg:<keyid> / cosign)")
+	f.StringArrayVar(&buildCfg.Notify, "notify", nil, "构建结束通知 (webhook:<url> / desktop)")
+	f.StringVar(&buildCfg.SBOM, "sbom", "", "为每个产物生成 SBOM (cyclonedx/spdx)")
+	f.CountVarP(&flagVerbose, "verbose", "v", "输出详细程度 (-v, -vv, -vvv)")
+	f.BoolVar(&buildCfg.TUI, "tui", false, "全屏仪表盘显示构建进度")
+	f.BoolVar(&buildCfg.CGO, "cgo", false, "启用 CGO")
+	f.IntVar(&buildCfg.AndroidAPI, "android-api", 21, "android 目标的 API 等级 (选择 NDK clang 包装器)")
+	f.BoolVar(&buildCfg.ABISplit, "abi-split", false, "android 产物按 ABI 整理到 lib/<abi>/ 布局")
+	f.BoolVar(&buildCfg.ABIZip, "abi-zip", false, "在 ABI 布局基础上打包 jniLibs zip (隐含 --abi-split)")
+	f.BoolVar(&buildCfg.Static, "static", false, "linux 目标产出并校验静态二进制 (musl/netgo)")
+	f.StringVar(&buildCfg.Smoke, "smoke", "", "构建后用这些参数执行产物做冒烟测试 (如 \"--version\")")
+	f.BoolVar(&buildCfg.WasmHarness, "wasm-harness", false, "为 wasm 产物生成 HTML/wasmtime 试跑载体")
+	f.StringVar(&buildCfg.BuildMode, "buildmode", "", "库构建模式 (c-shared/c-archive/pie)")
+	f.BoolVar(&buildCfg.Cover, "cover", false, "构建覆盖率插桩二进制 (运行时需 GOCOVERDIR)")
+	f.BoolVar(&buildCfg.Resume, "resume", false, "跳过上次矩阵中已完成的目标继续构建")
+	f.Float64Var(&flagAllowPartial, "allow-partial", -1, "成功目标百分比达到该值即整体视为成功 (0-100)")
+	f.BoolVar(&buildCfg.Compress, "compress", false, "压缩构建产物")
+	f.BoolVar(&buildCfg.SkipTests, "skip-tests", false, "跳过测试")
+	f.BoolVar(&buildCfg.Offline, "offline", false, "离线构建 (GOPROXY=off, 优先 vendor)")
+	f.BoolVar(&buildCfg.Generate, "generate", false, "构建前运行 go generate ./...")
+	f.StringSliceVar(&buildCfg.Lint, "lint", nil, "构建前静态检查 (vet,staticcheck)")
+	f.BoolVar(&buildCfg.WarmCache, "warm-cache", false, "并行构建前预热构建缓存")
+	f.IntVar(&buildCfg.MinFreeMB, "min-free", 0, "输出卷最低可用空间 (MB)，不足时中止")
+	f.StringVar(&flagLogFile, "log-file", "", "构建日志文件路径")
+	f.StringVar(&flagLogFormat, "log-format", "text", "日志格式 (text/json)")
+	f.StringVar(&flagLang, "lang", "", "输出语言 zh/en (默认根据 LANG 检测)")
+	f.BoolVarP(&utils.AssumeYes, "yes", "y", false, "非交互模式: 所有确认问题回答是")
+	f.StringVar(&flagRemote, "remote", "", "在远程机器构建 (user@host，经 ssh/rsync)")
+	f.StringSliceVar(&flagFarm, "farm", nil, "farm worker 地址列表 (host:port)，按能力分发目标")
+}
+
+// Execute 运行根命令
+func Execute() error {
+	rootCmd.Version = Version
+	rootCmd.SetArgs(normalizeLegacyArgs(os.Args[1:]))
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package commands
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/config"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/modtool"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+var (
+	selfUpdateURL    string
+	selfUpdatePubkey string
+)
+
+// selfUpdateClient 下载模块 zip 用的客户端
+var selfUpdateClient = &http.Client{Timeout: 5 * time.Minute}
+
+// moduleUpdate update.json 的结构 (与 modtool bump 生成的一致)
+type moduleUpdate struct {
+	Version     string `json:"version"`
+	VersionCode int    `json:"versionCode"`
+	ZipURL      string `json:"zipUrl"`
+	Changelog   string `json:"changelog"`
+}
+
+var selfUpdateCmd = &cobra.Command{
+	Use:   "self-update",
+	Short: "🔄 检查并暂存 gogogo 模块自身的更新 (设备端)",
+	Long: `读取模块 update.json 检查新版本，经配置的 GitHub 代理
+(config set github-proxy <地址>) 下载新版 zip，校验内嵌
+清单后解压到 /data/adb/modules_update/，下次重启生效。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		moduleDir := utils.ModuleDir()
+		if moduleDir == "" {
+			return fmt.Errorf("未找到已安装的 gogogo 模块，self-update 仅适用于以根模块方式安装的设备")
+		}
+		current, url, err := readInstalledModule(moduleDir)
+		if err != nil {
+			return err
+		}
+		if selfUpdateURL != "" {
+			url = selfUpdateURL
+		}
+		if url == "" {
+			return fmt.Errorf("module.prop 里没有 updateJson 地址，请用 --update-json 指定")
+		}
+
+		update, err := fetchUpdateJSON(url)
+		if err != nil {
+			return err
+		}
+		if update.VersionCode <= current {
+			fmt.Printf("✅ 已是最新版本 (versionCode %d)\n", current)
+			return nil
+		}
+		fmt.Printf("🆕 发现新版本 %s (versionCode %d → %d)\n", update.Version, current, update.VersionCode)
+		if update.Changelog != "" {
+			fmt.Printf("📋 更新日志: %s\n", update.Changelog)
+		}
+
+		zipPath, err := downloadModuleZip(update.ZipURL)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(zipPath)
+		if err := modtool.VerifyZip(zipPath, selfUpdatePubkey); err != nil {
+			return err
+		}
+		if err := stageModuleUpdate(zipPath, moduleDir); err != nil {
+			return err
+		}
+		fmt.Println("✅ 更新已暂存，重启设备后生效")
+		return nil
+	},
+}
+
+// readInstalledModule 读取已装模块的 versionCode 与 updateJson 地址
+func readInstalledModule(moduleDir string) (int, string, error) {
+	data, err := os.ReadFile(filepath.Join(moduleDir, "module.prop"))
+	if err != nil {
+		return 0, "", fmt.Errorf("读取模块 module.prop 失败: %w", err)
+	}
+	code, url := 0, ""
+	for _, line := range strings.Split(string(data), "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "versionCode":
+			code, _ = strconv.Atoi(strings.TrimSpace(value))
+		case "updateJson":
+			url = strings.TrimSpace(value)
+		}
+	}
+	return code, url, nil
+}
+
+// applyGitHubProxy 把 URL 套上配置的 GitHub 代理前缀 (ghproxy 风格)
+func applyGitHubProxy(url string) string {
+	values, err := config.Load()
+	if err != nil {
+		return url
+	}
+	proxy := values["github-proxy"]
+	if proxy == "" {
+		return url
+	}
+	return strings.TrimSuffix(proxy, "/") + "/" + url
+}
+
+// fetchUpdateJSON 拉取并解析 update.json
+func fetchUpdateJSON(url string) (*moduleUpdate, error) {
+	resp, err := selfUpdateClient.Get(applyGitHubProxy(url))
+	if err != nil {
+		return nil, fmt.Errorf("获取 update.json 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("获取 update.json 失败: HTTP %d", resp.StatusCode)
+	}
+	update := &moduleUpdate{}
+	if err := json.NewDecoder(resp.Body).Decode(update); err != nil {
+		return nil, fmt.Errorf("update.json 格式错误: %w", err)
+	}
+	if update.ZipURL == "" {
+		return nil, fmt.Errorf("update.json 缺少 zipUrl")
+	}
+	return update, nil
+}
+
+// downloadModuleZip 经代理下载模块 zip 到临时文件
+func downloadModuleZip(url string) (string, error) {
+	fmt.Printf("⬇️  下载 %s\n", url)
+	resp, err := selfUpdateClient.Get(applyGitHubProxy(url))
+	if err != nil {
+		return "", fmt.Errorf("下载失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("下载失败: HTTP %d", resp.StatusCode)
+	}
+	f, err := os.CreateTemp("", "gogogo-update-*.zip")
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
+
+// stageModuleUpdate 把新版解压到 modules_update，随下次重启由根方案合并
+func stageModuleUpdate(zipPath, moduleDir string) error {
+	dest := filepath.Join("/data/adb/modules_update", filepath.Base(moduleDir))
+	if err := os.RemoveAll(dest); err != nil {
+		return err
+	}
+	if err := extractZip(zipPath, dest); err != nil {
+		return fmt.Errorf("解压更新包失败: %w", err)
+	}
+	// update 标记让管理器界面显示待更新状态
+	return os.WriteFile(filepath.Join(moduleDir, "update"), nil, 0o644)
+}
+
+// extractZip 把 zip 解压到目标目录，拒绝越界路径
+func extractZip(zipPath, dest string) error {
+	zr, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		rel := filepath.Clean(filepath.FromSlash(f.Name))
+		if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("zip 内有越界路径: %s", f.Name)
+		}
+		path := filepath.Join(dest, rel)
+		if f.FileInfo().IsDir() {
+			if err := os.MkdirAll(path, 0o755); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		mode := f.Mode().Perm()
+		if mode == 0 {
+			mode = 0o644
+		}
+		out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+		if err != nil {
+			rc.Close()
+			return err
+		}
+		_, err = io.Copy(out, rc)
+		rc.Close()
+		out.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	selfUpdateCmd.Flags().StringVar(&selfUpdateURL, "update-json", "", "覆盖 update.json 地址")
+	selfUpdateCmd.Flags().StringVar(&selfUpdatePubkey, "pubkey", "", "校验签名用的发布者公钥文件")
+	rootCmd.AddCommand(selfUpdateCmd)
+}
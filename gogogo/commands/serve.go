@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+)
+
+var (
+	serveWorker bool
+	serveListen string
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "🏭 以 farm worker 身份监听并接受分发的构建任务",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !serveWorker {
+			return fmt.Errorf("目前仅支持 --worker 模式")
+		}
+		return gobuild.ServeWorker(serveListen)
+	},
+}
+
+func init() {
+	serveCmd.Flags().BoolVar(&serveWorker, "worker", false, "worker 模式")
+	serveCmd.Flags().StringVar(&serveListen, "listen", ":7654", "监听地址")
+	rootCmd.AddCommand(serveCmd)
+}
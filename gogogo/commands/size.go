@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	sizeOldDir   string
+	sizePackages bool
+)
+
+var sizeCmd = &cobra.Command{
+	Use:   "size",
+	Short: "📏 对比两次构建的产物体积，定位依赖膨胀",
+	Long: `扫描当前输出目录与 --old 指定的历史输出目录，
+按相对路径逐个对比产物大小并打印增量；
+--packages 额外用 go tool nm 统计单个产物的按包体积分布。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		current, err := collectSizes(buildCfg.OutputDir)
+		if err != nil {
+			return err
+		}
+		if len(current) == 0 {
+			return fmt.Errorf("输出目录 %s 中没有产物", buildCfg.OutputDir)
+		}
+
+		if sizeOldDir != "" {
+			old, err := collectSizes(sizeOldDir)
+			if err != nil {
+				return err
+			}
+			printSizeDiff(old, current)
+		} else {
+			printSizes(current)
+		}
+
+		if sizePackages {
+			for rel := range current {
+				if err := printPackageSizes(filepath.Join(buildCfg.OutputDir, rel)); err != nil {
+					fmt.Printf("⚠️  %s: %v\n", rel, err)
+				}
+			}
+		}
+		return nil
+	},
+}
+
+// collectSizes 递归收集目录下全部常规文件的大小 (相对路径 → 字节)
+func collectSizes(dir string) (map[string]int64, error) {
+	sizes := map[string]int64{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		sizes[filepath.ToSlash(rel)] = info.Size()
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("目录不存在: %s", dir)
+	}
+	return sizes, err
+}
+
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func printSizes(current map[string]int64) {
+	fmt.Println("📏 当前产物体积:")
+	for _, rel := range sortedKeys(current) {
+		fmt.Printf("  %-48s %10.2f MB\n", rel, float64(current[rel])/1024/1024)
+	}
+}
+
+func printSizeDiff(old, current map[string]int64) {
+	fmt.Printf("📏 体积对比 (基线: %s):\n", sizeOldDir)
+	for _, rel := range sortedKeys(current) {
+		newSize := current[rel]
+		oldSize, ok := old[rel]
+		if !ok {
+			fmt.Printf("  %-48s %10.2f MB  (新增)\n", rel, float64(newSize)/1024/1024)
+			continue
+		}
+		delta := newSize - oldSize
+		sign := "+"
+		if delta < 0 {
+			sign = ""
+		}
+		fmt.Printf("  %-48s %10.2f MB  (%s%.2f MB)\n",
+			rel, float64(newSize)/1024/1024, sign, float64(delta)/1024/1024)
+	}
+	for _, rel := range sortedKeys(old) {
+		if _, ok := current[rel]; !ok {
+			fmt.Printf("  %-48s 已移除 (原 %.2f MB)\n", rel, float64(old[rel])/1024/1024)
+		}
+	}
+}
+
+// printPackageSizes 用 go tool nm -size 按包聚合符号体积，打印前 15 名
+func printPackageSizes(binary string) error {
+	out, err := exec.Command("go", "tool", "nm", "-size", binary).Output()
+	if err != nil {
+		return fmt.Errorf("go tool nm 失败: %w", err)
+	}
+	byPkg := map[string]int64{}
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		sym := fields[3]
+		pkg := sym
+		if idx := strings.LastIndex(sym, "/"); idx >= 0 {
+			rest := sym[idx:]
+			if dot := strings.Index(rest, "."); dot >= 0 {
+				pkg = sym[:idx+dot]
+			}
+		} else if dot := strings.Index(sym, "."); dot >= 0 {
+			pkg = sym[:dot]
+		}
+		byPkg[pkg] += size
+	}
+	type entry struct {
+		pkg  string
+		size int64
+	}
+	entries := make([]entry, 0, len(byPkg))
+	for pkg, size := range byPkg {
+		entries = append(entries, entry{pkg, size})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].size > entries[j].size })
+	fmt.Printf("\n📦 %s 按包体积 Top 15:\n", binary)
+	for i, e := range entries {
+		if i >= 15 {
+			break
+		}
+		fmt.Printf("  %-56s %8.1f KB\n", e.pkg, float64(e.size)/1024)
+	}
+	return nil
+}
+
+func init() {
+	sizeCmd.Flags().StringVar(&sizeOldDir, "old", "", "历史输出目录 (基线)")
+	sizeCmd.Flags().BoolVar(&sizePackages, "packages", false, "按包统计体积分布 (go tool nm)")
+	rootCmd.AddCommand(sizeCmd)
+}
@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/i18n"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+var testEmulate bool
+
+var testCmd = &cobra.Command{
+	Use:   "test",
+	Short: "🧪 跨平台测试: 宿主运行 go test，交叉目标做 vet/编译验证",
+	Long: `对宿主平台完整运行 go test；对其余选定平台运行 go vet 与
+仅编译验证 (go test -c)。安装了 qemu-user 或 wasmtime 时，
+可通过 --emulate 在模拟器中真正执行交叉平台的测试。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := resolveBuildConfig(); err != nil {
+			return err
+		}
+		host := platform.Host(runtime.GOOS, runtime.GOARCH)
+		failed := 0
+		for _, p := range buildCfg.Platforms {
+			var err error
+			switch {
+			case p == host:
+				if buildCfg.SkipTests {
+					fmt.Printf("⏭️  %-20s 跳过测试 (--skip-tests)\n", p)
+					continue
+				}
+				err = runHostTests()
+			case testEmulate && (binfmtRegistered(p) || emulatorFor(p) != ""):
+				err = runEmulatedTests(p)
+			case testEmulate:
+				fmt.Printf("⚠️  %-20s 未找到 qemu-user/wasmtime，退回编译验证\n", p)
+				err = verifyTarget(p)
+			default:
+				err = verifyTarget(p)
+			}
+			if err != nil {
+				failed++
+				fmt.Printf("❌ %-20s %v\n", p, err)
+			} else {
+				fmt.Printf("✅ %-20s\n", p)
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf(i18n.T("test.failed_count"), failed)
+		}
+		return nil
+	},
+}
+
+// runHostTests 在宿主平台完整运行 go test
+func runHostTests() error {
+	cmd := exec.Command("go", "test", "./...")
+	cmd.Dir = buildCfg.Source
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// verifyTarget 对交叉目标执行 go vet 和仅编译验证
+func verifyTarget(p platform.Platform) error {
+	env := append(os.Environ(), "GOOS="+p.OS, "GOARCH="+p.Arch, "CGO_ENABLED=0")
+
+	vet := exec.Command("go", "vet", "./...")
+	vet.Dir = buildCfg.Source
+	vet.Env = env
+	if out, err := vet.CombinedOutput(); err != nil {
+		return fmt.Errorf("go vet 失败:\n%s", out)
+	}
+
+	build := exec.Command("go", "build", "-o", os.DevNull, "./...")
+	build.Dir = buildCfg.Source
+	build.Env = env
+	if out, err := build.CombinedOutput(); err != nil {
+		return fmt.Errorf("编译验证失败:\n%s", out)
+	}
+	return nil
+}
+
+// emulatorFor 返回可在宿主上执行该平台二进制的模拟器命令，没有则返回空串
+func emulatorFor(p platform.Platform) string {
+	return gobuild.EmulatorFor(p)
+}
+
+// binfmtArch GOARCH 对应的 binfmt_misc 注册名
+var binfmtArch = map[string]string{
+	"arm64":    "qemu-aarch64",
+	"arm":      "qemu-arm",
+	"riscv64":  "qemu-riscv64",
+	"s390x":    "qemu-s390x",
+	"ppc64le":  "qemu-ppc64le",
+	"mips64le": "qemu-mips64el",
+}
+
+// binfmtRegistered 检查内核是否已通过 binfmt_misc 注册该架构的
+// qemu 解释器 (docker 的 multiarch 镜像常这样配置)，
+// 注册后外架构二进制可直接执行，go test 无需 -exec
+func binfmtRegistered(p platform.Platform) bool {
+	if p.OS != "linux" || runtime.GOOS != "linux" {
+		return false
+	}
+	name, ok := binfmtArch[p.Arch]
+	if !ok {
+		return false
+	}
+	_, err := os.Stat("/proc/sys/fs/binfmt_misc/" + name)
+	return err == nil
+}
+
+// runEmulatedTests 在模拟器中执行测试：
+// binfmt 已注册时直接运行，否则通过 go test -exec 指定 qemu/wasmtime
+func runEmulatedTests(p platform.Platform) error {
+	args := []string{"test"}
+	how := "binfmt"
+	if !binfmtRegistered(p) {
+		how = emulatorFor(p)
+		args = append(args, "-exec", how)
+	}
+	args = append(args, "./...")
+	cmd := exec.Command("go", args...)
+	cmd.Dir = buildCfg.Source
+	cmd.Env = append(os.Environ(), "GOOS="+p.OS, "GOARCH="+p.Arch, "CGO_ENABLED=0")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("模拟器测试失败 (%s):\n%s", how, out)
+	}
+	return nil
+}
+
+func init() {
+	testCmd.Flags().BoolVar(&testEmulate, "emulate", false, "在 qemu-user/wasmtime 中执行交叉平台测试")
+	rootCmd.AddCommand(testCmd)
+}
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+	"github.com/spf13/cobra"
+)
+
+// wizardAnswers 向导收集的全部回答
+type wizardAnswers struct {
+	Source    string
+	Name      string
+	Platforms string
+	Profile   string
+	CGO       bool
+	Compress  bool
+}
+
+// wizardPlatformMenu 平台菜单：新人不用记组名，选编号即可
+var wizardPlatformMenu = []struct {
+	Label string
+	Value string
+}{
+	{"仅本机 (" + runtime.GOOS + "/" + runtime.GOARCH + ")", runtime.GOOS + "/" + runtime.GOARCH},
+	{"桌面平台 (windows/macos/linux)", "desktop"},
+	{"服务器平台 (linux/bsd)", "server"},
+	{"移动平台 (android/ios)", "mobile"},
+	{"全部平台", "all"},
+	{"自定义 (手动输入)", ""},
+}
+
+var wizardCmd = &cobra.Command{
+	Use:     "wizard",
+	Aliases: []string{"init"},
+	Short:   "🧙 交互式快速上手向导",
+	Long: `逐步询问源码路径、目标平台与常用选项后直接执行构建，
+不用面对根命令的三十多个 flag。回答可用 GOGOGO_ANSWER_*
+环境变量预置，供脚本复用。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		a, err := runInteractive()
+		if err != nil {
+			return err
+		}
+		if err := offerSavePreset(a); err != nil {
+			return err
+		}
+		return wizardBuild(cmd, a)
+	},
+}
+
+// runInteractive 逐项询问并返回回答
+func runInteractive() (*wizardAnswers, error) {
+	a := &wizardAnswers{}
+	a.Source = utils.Input("wizard.source", "源码路径", ".")
+	abs, err := filepath.Abs(a.Source)
+	if err != nil {
+		return nil, err
+	}
+	a.Name = utils.Input("wizard.name", "二进制名称", filepath.Base(abs))
+
+	fmt.Println("目标平台:")
+	for i, item := range wizardPlatformMenu {
+		fmt.Printf("  %d. %s\n", i+1, item.Label)
+	}
+	choice := utils.Input("wizard.platforms", fmt.Sprintf("选择 [1-%d]", len(wizardPlatformMenu)), "1")
+	a.Platforms, err = wizardPlatformChoice(choice)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Profile = utils.Input("wizard.profile", "构建预设 (release/debug/留空)", "")
+	a.CGO = utils.Confirm("wizard.cgo", "启用 CGO?")
+	a.Compress = utils.Confirm("wizard.compress", "压缩产物?")
+	return a, nil
+}
+
+// wizardPlatformChoice 把菜单编号换算成平台表达式；
+// 非数字输入当作平台表达式原样接受，超范围的编号直接报错
+func wizardPlatformChoice(choice string) (string, error) {
+	choice = strings.TrimSpace(choice)
+	if n, err := strconv.Atoi(choice); err == nil {
+		if n < 1 || n > len(wizardPlatformMenu) {
+			return "", fmt.Errorf("无效的选择: %s (可选 1-%d)", choice, len(wizardPlatformMenu))
+		}
+		item := wizardPlatformMenu[n-1]
+		if item.Value == "" {
+			return utils.Input("wizard.platforms_custom",
+				"平台列表 (如 linux/amd64,darwin/arm64)",
+				runtime.GOOS+"/"+runtime.GOARCH), nil
+		}
+		return item.Value, nil
+	}
+	if choice == "" {
+		return "", fmt.Errorf("无效的选择: %s", choice)
+	}
+	return choice, nil
+}
+
+// apply 把回答落到根命令的构建参数上
+func (a *wizardAnswers) apply() {
+	flagSources = []string{a.Source}
+	flagPlatforms = a.Platforms
+	flagProfile = a.Profile
+	buildCfg.Name = a.Name
+	buildCfg.CGO = a.CGO
+	buildCfg.Compress = a.Compress
+}
+
+// wizardBuild 按回答执行真正的构建 (旧版 -quick 只打印命令)
+func wizardBuild(cmd *cobra.Command, a *wizardAnswers) error {
+	a.apply()
+	if err := resolveBuildConfig(); err != nil {
+		return withExitCode(err, ExitConfig)
+	}
+	if !utils.Confirm("wizard.go", fmt.Sprintf("开始构建 %s (%s)?", a.Name, a.Platforms)) {
+		fmt.Println("⏭️  已取消")
+		return nil
+	}
+	report, err := gobuild.BuildWithProgress(buildCfg)
+	return buildExitError(report, err)
+}
+
+func init() {
+	rootCmd.AddCommand(wizardCmd)
+}
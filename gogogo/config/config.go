@@ -0,0 +1,106 @@
+// Package config 维护 gogogo 的持久用户配置 (~/.config/gogogo/config.yaml)。
+// 文件是扁平的 key: value 形式，手写友好，不引入 YAML 依赖。
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// Path 返回配置文件路径：
+// Android 上优先模块目录，其余遵循 XDG (~/.config/gogogo/config.yaml)
+func Path() (string, error) {
+	if env := os.Getenv("GOGOGO_CONFIG"); env != "" {
+		return env, nil
+	}
+	if runtime.GOOS == "android" {
+		if dir := utils.ModuleDir(); dir != "" {
+			return filepath.Join(dir, "config.yaml"), nil
+		}
+	}
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		base = filepath.Join(home, ".config")
+	}
+	return filepath.Join(base, "gogogo", "config.yaml"), nil
+}
+
+// Load 读取全部配置项，文件不存在时返回空表
+func Load() (map[string]string, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	values := map[string]string{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: 不是 key: value 格式: %q", path, i+1, line)
+		}
+		values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return values, nil
+}
+
+// Save 按键名排序写回全部配置项
+func Save(values map[string]string) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString("# gogogo 用户配置，由 `gogogo config set` 维护\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s: %s\n", k, values[k])
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+// Set 写入单个配置项
+func Set(key, value string) error {
+	values, err := Load()
+	if err != nil {
+		return err
+	}
+	values[key] = value
+	return Save(values)
+}
+
+// Unset 删除单个配置项
+func Unset(key string) error {
+	values, err := Load()
+	if err != nil {
+		return err
+	}
+	delete(values, key)
+	return Save(values)
+}
@@ -0,0 +1,254 @@
+// Package daemon 提供常驻 HTTP 服务，
+// 供模块 WebUI / CI 等工具以 REST API 触发并跟踪构建
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/events"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// BuildRequest POST /builds 的请求体
+type BuildRequest struct {
+	Source    string `json:"source"`
+	Name      string `json:"name"`
+	OutputDir string `json:"output_dir"`
+	Platforms string `json:"platforms"`
+	Ldflags   string `json:"ldflags"`
+	Tags      string `json:"tags"`
+	Parallel  int    `json:"parallel"`
+}
+
+// JobStatus 构建任务状态
+type JobStatus string
+
+const (
+	JobQueued  JobStatus = "queued"
+	JobRunning JobStatus = "running"
+	JobDone    JobStatus = "done"
+	JobFailed  JobStatus = "failed"
+)
+
+// Job 一次由 API 触发的构建
+type Job struct {
+	ID        string       `json:"id"`
+	Status    JobStatus    `json:"status"`
+	Request   BuildRequest `json:"request"`
+	CreatedAt time.Time    `json:"created_at"`
+	Error     string       `json:"error,omitempty"`
+	Results   []JobResult  `json:"results,omitempty"`
+}
+
+// JobResult 单目标结果摘要
+type JobResult struct {
+	Platform string  `json:"platform"`
+	Success  bool    `json:"success"`
+	Skipped  bool    `json:"skipped"`
+	Seconds  float64 `json:"seconds"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// Server 构建守护进程
+type Server struct {
+	mu   sync.Mutex
+	jobs map[string]*Job
+	seq  int
+	// bus 流水线事件总线，经 GET /events 以 SSE 推送
+	bus *events.Bus
+	// metrics GET /metrics 暴露的运行指标
+	metrics *metrics
+}
+
+// New 创建空的守护进程实例
+func New() *Server {
+	return &Server{jobs: map[string]*Job{}, bus: events.NewBus(), metrics: newMetrics()}
+}
+
+// ListenAndServe 启动 REST API
+func (s *Server) ListenAndServe(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/builds", s.handleBuilds)
+	mux.HandleFunc("/builds/", s.handleBuild)
+	mux.HandleFunc("/events", s.handleEvents)
+	mux.HandleFunc("/metrics", s.metrics.handleMetrics)
+	fmt.Printf("🛰️  gogogo daemon 监听 %s\n", addr)
+	return http.ListenAndServe(addr, mux)
+}
+
+// handleEvents 以 Server-Sent Events 推送全部任务的目标级进度，
+// ?job=<id> 可只订阅单个任务
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	flusher.Flush()
+
+	jobFilter := r.URL.Query().Get("job")
+	ch, cancel := s.bus.Subscribe()
+	defer cancel()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case ev := <-ch:
+			if jobFilter != "" && ev.Job != jobFilter {
+				continue
+			}
+			data, err := json.Marshal(ev)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, data)
+			flusher.Flush()
+		}
+	}
+}
+
+func (s *Server) handleBuilds(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		// 持锁拷贝：setStatus 会并发改写 Job 字段，
+		// 解锁后再序列化指针就是数据竞争
+		s.mu.Lock()
+		jobs := make([]Job, 0, len(s.jobs))
+		for _, job := range s.jobs {
+			jobs = append(jobs, *job)
+		}
+		s.mu.Unlock()
+		writeJSON(w, http.StatusOK, jobs)
+	case http.MethodPost:
+		var req BuildRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "无效的请求体: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		job, err := s.submit(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, http.StatusAccepted, job)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleBuild(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/builds/")
+	s.mu.Lock()
+	job, ok := s.jobs[id]
+	var snapshot Job
+	if ok {
+		snapshot = *job
+	}
+	s.mu.Unlock()
+	if !ok {
+		http.Error(w, "未知的构建 ID", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, snapshot)
+}
+
+// submit 登记并异步执行一个构建任务。
+// 返回 Job 的值拷贝：异步执行随时会改写原对象
+func (s *Server) submit(req BuildRequest) (Job, error) {
+	platforms, err := platform.Parse(req.Platforms)
+	if err != nil {
+		return Job{}, err
+	}
+	if req.Source == "" {
+		req.Source = "."
+	}
+	if req.Name == "" {
+		req.Name = "app"
+	}
+	if req.OutputDir == "" {
+		req.OutputDir = "build"
+	}
+	if req.Parallel <= 0 {
+		req.Parallel = runtime.NumCPU()
+	}
+
+	s.mu.Lock()
+	s.seq++
+	job := &Job{
+		ID:        fmt.Sprintf("%d", s.seq),
+		Status:    JobQueued,
+		Request:   req,
+		CreatedAt: time.Now(),
+	}
+	s.jobs[job.ID] = job
+	snapshot := *job
+	s.mu.Unlock()
+
+	go s.run(job, platforms)
+	return snapshot, nil
+}
+
+func (s *Server) run(job *Job, platforms []platform.Platform) {
+	cfg := &gobuild.Config{
+		Source:    job.Request.Source,
+		OutputDir: job.Request.OutputDir,
+		Name:      job.Request.Name,
+		Platforms: platforms,
+		Ldflags:   job.Request.Ldflags,
+		Tags:      job.Request.Tags,
+		Parallel:  job.Request.Parallel,
+		Retry:     gobuild.DefaultRetryPolicy,
+		Events:    events.WithJob(s.bus, job.ID),
+	}
+	s.setStatus(job, JobRunning, nil, nil)
+	s.metrics.jobStarted()
+	report, err := gobuild.BuildWithProgress(cfg)
+	utils.Logger.Info("daemon.job_done", "id", job.ID, "success", err == nil)
+	if err != nil {
+		s.setStatus(job, JobFailed, report, err)
+	} else {
+		s.setStatus(job, JobDone, report, nil)
+	}
+	s.metrics.jobFinished(job)
+}
+
+func (s *Server) setStatus(job *Job, status JobStatus, report *gobuild.Report, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job.Status = status
+	if err != nil {
+		job.Error = err.Error()
+	}
+	if report != nil {
+		job.Results = nil
+		for _, res := range report.Results {
+			jr := JobResult{
+				Platform: res.Platform.String(),
+				Success:  res.Err == nil && !res.Skipped,
+				Skipped:  res.Skipped,
+				Seconds:  res.Duration.Seconds(),
+			}
+			if res.Err != nil {
+				jr.Error = res.Err.Error()
+			}
+			job.Results = append(job.Results, jr)
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
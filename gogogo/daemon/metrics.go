@@ -0,0 +1,86 @@
+package daemon
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// metrics daemon 的运行指标，手写 Prometheus 文本格式暴露，
+// 避免为几个计数器引入完整的 client_golang 依赖
+type metrics struct {
+	mu sync.Mutex
+	// jobsTotal 按最终状态统计的任务数 (done/failed)
+	jobsTotal map[string]int64
+	// jobsRunning 正在执行的任务数
+	jobsRunning int64
+	// targetsTotal 按结果统计的目标数 (success/failed/skipped)
+	targetsTotal map[string]int64
+	// durationSum/durationCount 目标构建耗时累计，用于算平均
+	durationSum   float64
+	durationCount int64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		jobsTotal:    map[string]int64{},
+		targetsTotal: map[string]int64{},
+	}
+}
+
+// jobStarted 任务进入运行态
+func (m *metrics) jobStarted() {
+	m.mu.Lock()
+	m.jobsRunning++
+	m.mu.Unlock()
+}
+
+// jobFinished 任务结束，按任务状态与各目标结果计数
+func (m *metrics) jobFinished(job *Job) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobsRunning--
+	m.jobsTotal[string(job.Status)]++
+	for _, res := range job.Results {
+		switch {
+		case res.Skipped:
+			m.targetsTotal["skipped"]++
+		case res.Success:
+			m.targetsTotal["success"]++
+		default:
+			m.targetsTotal["failed"]++
+		}
+		m.durationSum += res.Seconds
+		m.durationCount++
+	}
+}
+
+// handleMetrics GET /metrics 输出 Prometheus 文本格式
+func (m *metrics) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	fmt.Fprintln(w, "# HELP gogogo_jobs_total 按最终状态统计的构建任务数")
+	fmt.Fprintln(w, "# TYPE gogogo_jobs_total counter")
+	for status, n := range m.jobsTotal {
+		fmt.Fprintf(w, "gogogo_jobs_total{status=%q} %d\n", status, n)
+	}
+
+	fmt.Fprintln(w, "# HELP gogogo_jobs_running 正在执行的构建任务数")
+	fmt.Fprintln(w, "# TYPE gogogo_jobs_running gauge")
+	fmt.Fprintf(w, "gogogo_jobs_running %d\n", m.jobsRunning)
+
+	fmt.Fprintln(w, "# HELP gogogo_targets_total 按结果统计的目标数")
+	fmt.Fprintln(w, "# TYPE gogogo_targets_total counter")
+	for status, n := range m.targetsTotal {
+		fmt.Fprintf(w, "gogogo_targets_total{status=%q} %d\n", status, n)
+	}
+
+	// 无分位数的 summary：_sum/_count 必须挂在同名指标族下，
+	// 拆成两个独立 counter 过不了 promtool 校验
+	fmt.Fprintln(w, "# HELP gogogo_target_duration_seconds 目标构建耗时")
+	fmt.Fprintln(w, "# TYPE gogogo_target_duration_seconds summary")
+	fmt.Fprintf(w, "gogogo_target_duration_seconds_sum %f\n", m.durationSum)
+	fmt.Fprintf(w, "gogogo_target_duration_seconds_count %d\n", m.durationCount)
+}
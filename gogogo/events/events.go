@@ -0,0 +1,110 @@
+// Package events 定义构建流水线的事件模型与进程内事件总线。
+// daemon/serve 模式把事件经 SSE 推给 WebUI 等前端渲染实时进度，
+// 事件结构是对外契约，字段只增不改。
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+// Type 目标生命周期事件类型
+type Type string
+
+const (
+	// TypeQueued 目标进入队列
+	TypeQueued Type = "queued"
+	// TypeStarted 目标开始构建
+	TypeStarted Type = "started"
+	// TypeRetrying 目标构建失败，即将重试
+	TypeRetrying Type = "retrying"
+	// TypeFinished 目标结束 (成功/失败/跳过见 Status)
+	TypeFinished Type = "finished"
+)
+
+// Event 单条流水线事件
+type Event struct {
+	// Seq 总线内单调递增序号
+	Seq int64 `json:"seq"`
+	// Time 事件时间
+	Time time.Time `json:"time"`
+	// Type 事件类型
+	Type Type `json:"type"`
+	// Target 目标标识 (os/arch[/variant]，可带命令名前缀)
+	Target string `json:"target"`
+	// Job daemon 模式下的任务 ID，其余场景为空
+	Job string `json:"job,omitempty"`
+	// Attempt retrying 事件的已失败次数
+	Attempt int `json:"attempt,omitempty"`
+	// Status finished 事件的结果: success/failed/skipped
+	Status string `json:"status,omitempty"`
+	// Error finished 失败时的错误摘要
+	Error string `json:"error,omitempty"`
+	// CGOEnv CGO 目标 finished 事件附带的最终工具链变量
+	// (CC/CXX/CGO_CFLAGS/CGO_LDFLAGS)
+	CGOEnv map[string]string `json:"cgo_env,omitempty"`
+}
+
+// Publisher 事件发布方，gobuild 只依赖该接口
+type Publisher interface {
+	Publish(Event)
+}
+
+// Bus 进程内事件总线：多订阅者，慢订阅者丢事件不阻塞构建
+type Bus struct {
+	mu   sync.Mutex
+	seq  int64
+	subs map[chan Event]bool
+}
+
+// NewBus 创建事件总线
+func NewBus() *Bus {
+	return &Bus{subs: map[chan Event]bool{}}
+}
+
+// Publish 给事件编号并分发到所有订阅者
+func (b *Bus) Publish(ev Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	ev.Seq = b.seq
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	for ch := range b.subs {
+		select {
+		case ch <- ev:
+		default: // 订阅者跟不上就丢弃，构建不等前端
+		}
+	}
+}
+
+// Subscribe 订阅事件流，返回通道与取消函数
+func (b *Bus) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, 64)
+	b.mu.Lock()
+	b.subs[ch] = true
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subs, ch)
+		b.mu.Unlock()
+		close(ch)
+	}
+}
+
+// jobPublisher 给每条事件盖上任务 ID 的包装
+type jobPublisher struct {
+	inner Publisher
+	job   string
+}
+
+func (p jobPublisher) Publish(ev Event) {
+	ev.Job = p.job
+	p.inner.Publish(ev)
+}
+
+// WithJob 返回把 Job 字段固定为 job 的发布方包装
+func WithJob(p Publisher, job string) Publisher {
+	return jobPublisher{inner: p, job: job}
+}
@@ -0,0 +1,10 @@
+module github.com/LIghtJUNction/RootManage-Module-Model/gogogo
+
+go 1.21
+
+require github.com/spf13/cobra v1.8.0
+
+require (
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/spf13/pflag v1.0.5 // indirect
+)
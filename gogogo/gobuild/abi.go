@@ -0,0 +1,112 @@
+package gobuild
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// abiName 返回 GOARCH 对应的 Android ABI 目录名
+func abiName(goarch string) string {
+	switch goarch {
+	case "arm64":
+		return "arm64-v8a"
+	case "arm":
+		return "armeabi-v7a"
+	case "amd64":
+		return "x86_64"
+	case "386":
+		return "x86"
+	}
+	return ""
+}
+
+// collectABILayout 把 android 产物按 ABI 整理到 lib/<abi>/ 下
+// (如 lib/arm64-v8a/)，可直接放进 APK 或 Magisk 模块结构。
+// 开启 --abi-zip 时同时打出一个 jniLibs 风格的 zip。
+func collectABILayout(cfg *Config, report *Report) error {
+	libDir := filepath.Join(cfg.OutputDir, "lib")
+	copied := 0
+	for _, res := range report.Results {
+		if res.Err != nil || res.Skipped || res.Platform.OS != "android" {
+			continue
+		}
+		abi := abiName(res.Platform.Arch)
+		if abi == "" {
+			continue
+		}
+		dst := filepath.Join(libDir, abi, filepath.Base(res.OutputPath))
+		if err := copyFile(res.OutputPath, dst); err != nil {
+			return fmt.Errorf("整理 ABI 目录失败: %w", err)
+		}
+		copied++
+	}
+	if copied == 0 {
+		return nil
+	}
+	fmt.Printf("📦 已按 ABI 整理 %d 个 android 产物到 %s\n", copied, libDir)
+	utils.Logger.Info("abi.layout", "dir", libDir, "count", copied)
+	if cfg.ABIZip {
+		zipPath := filepath.Join(cfg.OutputDir, cfg.Name+"-jniLibs.zip")
+		if err := zipDir(libDir, "lib", zipPath); err != nil {
+			return fmt.Errorf("打包 jniLibs zip 失败: %w", err)
+		}
+		fmt.Printf("📦 jniLibs 包: %s\n", zipPath)
+	}
+	return nil
+}
+
+// copyFile 复制文件并保留可执行权限
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// zipDir 把目录打包为 zip，条目以 prefix 开头 (如 lib/arm64-v8a/app)
+func zipDir(dir, prefix, zipPath string) error {
+	f, err := os.Create(zipPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zw := zip.NewWriter(f)
+	defer zw.Close()
+
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		w, err := zw.Create(filepath.ToSlash(filepath.Join(prefix, rel)))
+		if err != nil {
+			return err
+		}
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		_, err = io.Copy(w, in)
+		return err
+	})
+}
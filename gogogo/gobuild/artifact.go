@@ -0,0 +1,28 @@
+package gobuild
+
+import (
+	"path/filepath"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+// 产物路径此前由构建器与汇总报告各算各的，两边口径不一致时
+// 汇总表会对成功的构建报"文件未找到"。所有路径计算收拢到这里。
+
+// ArtifactDir 单个目标的产物目录
+func ArtifactDir(cfg *Config, p platform.Platform) string {
+	if cfg.ArtifactLayout == "flat" {
+		return cfg.OutputDir
+	}
+	return filepath.Join(cfg.OutputDir, p.OS, p.ArchLabel()+"-"+cfg.Name)
+}
+
+// ArtifactPath 单个目标的产物文件路径。
+// nested (默认) 为 <输出>/<os>/<arch>-<名>/<名><后缀>，
+// flat 把所有产物平铺成 <输出>/<名>-<os>-<arch><后缀>
+func ArtifactPath(cfg *Config, p platform.Platform, ext string) string {
+	if cfg.ArtifactLayout == "flat" {
+		return filepath.Join(cfg.OutputDir, cfg.Name+"-"+p.OS+"-"+p.ArchLabel()+ext)
+	}
+	return filepath.Join(ArtifactDir(cfg, p), cfg.Name+ext)
+}
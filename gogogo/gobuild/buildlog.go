@@ -0,0 +1,26 @@
+package gobuild
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// writeTargetLog 把目标的完整 go build 输出写到
+// <output>/logs/<os>_<arch>.log，并行构建的交错输出没法用来
+// 定位单个平台的问题，这里始终落盘。写入失败只记日志不影响构建。
+func writeTargetLog(cfg *Config, p platform.Platform, data []byte) string {
+	logDir := filepath.Join(cfg.OutputDir, "logs")
+	if err := os.MkdirAll(logDir, 0o755); err != nil {
+		utils.Logger.Error("buildlog.mkdir_failed", "dir", logDir, "error", err.Error())
+		return ""
+	}
+	path := filepath.Join(logDir, p.OS+"_"+p.ArchLabel()+".log")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		utils.Logger.Error("buildlog.write_failed", "path", path, "error", err.Error())
+		return ""
+	}
+	return path
+}
@@ -0,0 +1,62 @@
+package gobuild
+
+import (
+	"fmt"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+// buildModeOS 各 buildmode 支持的目标系统 (按 go help buildmode 与
+// cmd/go 的平台表整理，按 OS 粒度校验)
+var buildModeOS = map[string]map[string]bool{
+	"c-shared": {
+		"linux": true, "android": true, "darwin": true,
+		"freebsd": true, "windows": true,
+	},
+	"c-archive": {
+		"linux": true, "darwin": true, "ios": true,
+		"freebsd": true, "windows": true, "aix": true,
+	},
+	"pie": {
+		"linux": true, "android": true, "darwin": true, "ios": true,
+		"freebsd": true, "netbsd": true, "openbsd": true, "windows": true, "aix": true,
+	},
+}
+
+// validateBuildMode 校验目标平台是否支持该 buildmode
+func validateBuildMode(mode string, p platform.Platform) error {
+	supported, ok := buildModeOS[mode]
+	if !ok {
+		return fmt.Errorf("不支持的 --buildmode: %q (可选 c-shared/c-archive/pie)", mode)
+	}
+	if !supported[p.OS] {
+		return fmt.Errorf("%s 不支持 buildmode %s", p.OS, mode)
+	}
+	return nil
+}
+
+// buildModeExt 返回 buildmode 对应的产物扩展名，
+// 普通可执行模式返回平台默认扩展名
+func buildModeExt(mode string, p platform.Platform) string {
+	switch mode {
+	case "c-shared":
+		switch p.OS {
+		case "windows":
+			return ".dll"
+		case "darwin", "ios":
+			return ".dylib"
+		}
+		return ".so"
+	case "c-archive":
+		if p.OS == "windows" {
+			return ".lib"
+		}
+		return ".a"
+	}
+	return p.Ext()
+}
+
+// buildModeNeedsCGO c-shared/c-archive 导出 C ABI，必须开 CGO
+func buildModeNeedsCGO(mode string) bool {
+	return mode == "c-shared" || mode == "c-archive"
+}
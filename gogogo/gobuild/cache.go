@@ -0,0 +1,46 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// sharedGoCache 缓存一次性探测到的 GOCACHE 路径，
+// 显式注入每个 worker 的环境，确保宽矩阵共享同一份构建缓存
+var sharedGoCache string
+
+// resolveGoCache 探测宿主 GOCACHE 路径
+func resolveGoCache() string {
+	if sharedGoCache != "" {
+		return sharedGoCache
+	}
+	out, err := exec.Command("go", "env", "GOCACHE").Output()
+	if err != nil {
+		return ""
+	}
+	sharedGoCache = strings.TrimSpace(string(out))
+	return sharedGoCache
+}
+
+// warmCache 并行交叉构建前用宿主平台预编译一次，
+// 填充模块缓存与可复用的构建缓存，降低宽矩阵中的重复工作
+func warmCache(cfg *Config) error {
+	fmt.Printf("🔥 预热构建缓存 (%s/%s)\n", runtime.GOOS, runtime.GOARCH)
+	start := time.Now()
+	cmd := exec.Command("go", "build", "-o", os.DevNull, cfg.Source)
+	cmd.Dir = "."
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=0")
+	out, err := cmd.CombinedOutput()
+	utils.Logger.Info("cache.warm", "success", err == nil, "duration", time.Since(start).String())
+	if err != nil {
+		return fmt.Errorf("缓存预热失败: %w\n%s", err, out)
+	}
+	fmt.Printf("🔥 预热完成 (%.1fs)\n", time.Since(start).Seconds())
+	return nil
+}
@@ -0,0 +1,41 @@
+package gobuild
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+var (
+	cgoProbeMu    sync.Mutex
+	cgoProbeCache = map[string]bool{}
+)
+
+// sourceNeedsCGO 用 go list -deps 探测源码的非标准库依赖中
+// 是否存在 cgo 包。纯 Go 程序即便面向 android/ios 也可以
+// CGO_ENABLED=0 构建，无需 NDK。结果按 (工作目录, 源码) 缓存：
+// 多主包构建里各 main 包的答案可能不同，不能全进程共享一份
+func sourceNeedsCGO(source, dir string) bool {
+	key := dir + "\x00" + source
+	cgoProbeMu.Lock()
+	defer cgoProbeMu.Unlock()
+	if needs, ok := cgoProbeCache[key]; ok {
+		return needs
+	}
+	// 探测失败时保守假设需要 CGO
+	needs := true
+	cmd := exec.Command("go", "list", "-deps",
+		"-f", "{{if and .CgoFiles (not .Standard)}}{{.ImportPath}}{{end}}", source)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=1")
+	if out, err := cmd.Output(); err == nil {
+		pkgs := strings.TrimSpace(string(out))
+		needs = pkgs != ""
+		utils.Logger.Info("cgo.probe", "source", source, "needs_cgo", needs, "packages", pkgs)
+	}
+	cgoProbeCache[key] = needs
+	return needs
+}
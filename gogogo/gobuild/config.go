@@ -0,0 +1,130 @@
+package gobuild
+
+import (
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/events"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+// Config 一次构建矩阵的全部配置
+type Config struct {
+	// Source 要编译的主包路径（默认 "."，多主包构建时为第一个）
+	Source string
+	// Sources 多主包构建的完整包列表 (含 Source)，
+	// 超过一个时每个命令输出到独立子目录
+	Sources []string
+	// Dir 构建命令的工作目录，空串为进程当前目录。
+	// farm worker 等并发场景靠它隔离源码树，绝不能改进程 cwd
+	Dir string
+	// OutputDir 产物输出目录
+	OutputDir string
+	// OutputLayout 输出布局: flat (默认) 或 versioned
+	// (产物落在 <out>/<版本>/ 下并维护 latest 链接)
+	OutputLayout string
+	// Version versioned 布局使用的版本号，空串时自动从 git describe 推导
+	Version string
+	// ArtifactLayout 单目标产物的目录口径: nested (默认，<os>/<arch>-<名>/)
+	// 或 flat (全部平铺为 <名>-<os>-<arch>)
+	ArtifactLayout string
+	// Name 输出的二进制名（默认取当前目录名）
+	Name string
+	// Platforms 展开后的目标平台列表
+	Platforms []platform.Platform
+
+	Ldflags string
+	Tags    string
+	// GoFlags 透传给 go build 的额外 flag 串 (shell 语义切分)
+	GoFlags string
+	// TagsMatrix 标签集列表，非空时每个平台按每个标签集各构建一次，
+	// 产物名追加标签集后缀 (如 app_sqlite)
+	TagsMatrix []string
+
+	// PreHooks 每个目标构建前执行的 shell 命令
+	PreHooks []string
+	// PostHooks 每个目标构建后执行的 shell 命令，可通过
+	// GOGOGO_STATUS 等环境变量获知构建结果
+	PostHooks []string
+	// PluginsDir 产物后处理插件目录 (默认 gogogo.plugins)
+	PluginsDir string
+	// Sign 产物签名方式: "gpg:<keyid>" 或 "cosign"，空串不签名
+	Sign string
+	// Notify 构建结束后的通知目标: "webhook:<url>" / "desktop"
+	Notify []string
+	// SBOM 为每个产物生成软件物料清单: "cyclonedx" / "spdx"
+	SBOM string
+	// GoVersion 指定本次构建的 Go 工具链版本 (经 GOTOOLCHAIN，自动下载)
+	GoVersion string
+	// ToolVersion 当前 gogogo 自身的版本，写进环境快照做溯源
+	ToolVersion string
+
+	// Parallel 并行编译数
+	Parallel int
+	// MinParallel / MaxParallel 动态伸缩的并发下限与上限，
+	// 0 分别表示 1 与 Parallel
+	MinParallel int
+	MaxParallel int
+	// LowPriority 用 nice/ionice 降低编译进程优先级，避免拖垮前台
+	LowPriority bool
+	// CgroupMemory linux + --low-priority 时经 systemd-run
+	// 给编译进程套内存上限 cgroup (如 2G)，空串不限制
+	CgroupMemory string
+	// FailFast 任一目标失败后取消尚未开始的目标
+	FailFast bool
+	// Retry 失败重试策略
+	Retry RetryPolicy
+	// Verbosity 输出详细程度 0-3
+	Verbosity int
+	// TUI 用全屏仪表盘替代逐行进度输出
+	TUI bool
+
+	// CGO 是否启用 CGO
+	CGO bool
+	// AndroidAPI android 目标使用的 API 等级 (选择 NDK clang 包装器)
+	AndroidAPI int
+	// ABISplit 把 android 产物按 ABI 整理到 lib/<abi>/ 布局
+	ABISplit bool
+	// ABIZip 在 ABI 布局基础上再打一个 jniLibs 风格的 zip
+	ABIZip bool
+	// Cover 用 -cover 构建插桩二进制，运行时经 GOCOVERDIR 收集覆盖率
+	Cover bool
+	// Resume 崩溃/中断后续跑: 按断点文件跳过上次已完成的目标
+	Resume bool
+	// BuildMode 传给 go build 的 -buildmode (c-shared/c-archive/pie)，
+	// 空串为普通可执行文件；扩展名与 CGO 开关随之调整
+	BuildMode string
+	// WasmHarness 为 wasm 产物生成试跑载体 (js: HTML 页，wasip1: wasmtime 脚本)
+	WasmHarness bool
+	// Smoke 构建后用这些参数执行产物做冒烟测试，
+	// 仅限宿主可运行的目标 (同平台或有 qemu/wasmtime)
+	Smoke string
+	// Static linux 目标产出静态二进制: 纯 Go 用 netgo/osusergo 标签，
+	// CGO 用 musl + -extldflags=-static，构建后校验 ELF
+	Static bool
+	// Compress 构建后是否压缩产物
+	Compress bool
+	// SkipTests 跳过测试
+	SkipTests bool
+	// Offline 离线构建: GOPROXY=off，优先 vendor，启动前校验依赖完整
+	Offline bool
+	// NonInteractive 禁止一切 stdin 交互 (库调用/daemon 场景)，
+	// 缺少 NDK 等情况直接降级或跳过而不是询问
+	NonInteractive bool
+	// GoCache 显式指定 GOCACHE 路径，绕过宿主缓存的探测与记忆
+	// (bench 的冷缓存场景用，空串走共享缓存)
+	GoCache string
+	// Generate 构建矩阵前运行一次 go generate ./...
+	Generate bool
+	// Lint 构建前运行的静态检查器列表 (vet/staticcheck)
+	Lint []string
+	// WarmCache 并行构建前先用宿主平台预热构建缓存
+	WarmCache bool
+	// MinFreeMB 输出卷最低可用空间 (MB)，低于该值中止构建，0 不检查
+	MinFreeMB int
+	// SkipTargets 配置中标注跳过的目标 (平台 → 原因)，
+	// 经 `gogogo config set skip.plan9/arm <原因>` 维护
+	SkipTargets map[string]string
+	// XFailTargets 预期失败的目标 (平台 → 原因)：照常构建，
+	// 失败时单独报告且不影响退出码
+	XFailTargets map[string]string
+	// Events 可选的事件发布方，daemon/serve 模式经 SSE 推给前端
+	Events events.Publisher
+}
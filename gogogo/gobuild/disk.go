@@ -0,0 +1,45 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+)
+
+// estimatedBinaryMB 单个产物的保守估计大小
+const estimatedBinaryMB = 20
+
+// estimateRequiredMB 估算整个矩阵需要的磁盘空间：
+// 每个目标一个二进制，开压缩时临时文件再翻一倍
+func estimateRequiredMB(cfg *Config, taskCount int) int64 {
+	required := int64(taskCount) * estimatedBinaryMB
+	if cfg.Compress {
+		required *= 2
+	}
+	return required
+}
+
+// FreeSpaceMB 返回路径所在卷的可用空间 (MB)，
+// 平台不支持探测时返回 -1。供 doctor 等诊断场景使用。
+func FreeSpaceMB(path string) int64 {
+	return freeSpaceMB(path)
+}
+
+// preflightDisk 构建前检查输出卷的可用空间：
+// 低于估算值时告警，低于 --min-free 时直接中止
+func preflightDisk(cfg *Config, taskCount int) error {
+	if err := os.MkdirAll(cfg.OutputDir, 0o755); err != nil {
+		return err
+	}
+	free := freeSpaceMB(cfg.OutputDir)
+	if free < 0 {
+		return nil // 平台不支持探测
+	}
+	required := estimateRequiredMB(cfg, taskCount)
+	if cfg.MinFreeMB > 0 && free < int64(cfg.MinFreeMB) {
+		return fmt.Errorf("输出卷可用空间 %d MB 低于 --min-free %d MB，中止构建", free, cfg.MinFreeMB)
+	}
+	if free < required {
+		fmt.Printf("⚠️  可用空间 %d MB 可能不足 (预计需要约 %d MB)\n", free, required)
+	}
+	return nil
+}
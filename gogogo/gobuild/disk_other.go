@@ -0,0 +1,8 @@
+//go:build !unix
+
+package gobuild
+
+// freeSpaceMB 在不支持 Statfs 的平台返回 -1 (跳过检查)
+func freeSpaceMB(path string) int64 {
+	return -1
+}
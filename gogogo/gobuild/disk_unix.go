@@ -0,0 +1,14 @@
+//go:build unix
+
+package gobuild
+
+import "syscall"
+
+// freeSpaceMB 返回 path 所在卷的可用空间 (MB)，失败返回 -1
+func freeSpaceMB(path string) int64 {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return -1
+	}
+	return int64(st.Bavail) * st.Bsize / 1024 / 1024
+}
@@ -0,0 +1,100 @@
+package gobuild
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// planEntry 单个目标的构建计划：完整命令与环境覆盖
+type planEntry struct {
+	Target  string   `json:"target"`
+	Output  string   `json:"output"`
+	Command []string `json:"command"`
+	Env     []string `json:"env"`
+}
+
+// planTarget 不执行任何命令，仅复算 BuildSingle 会使用的
+// 参数与环境覆盖。参数与基础环境的组装直接复用
+// assembleBuildArgs/assembleBaseEnv，两边不会再各自漂移；
+// 仅 NDK 探测保持尽力而为 (不存在时也不询问)
+func planTarget(cfg *Config, p platform.Platform) (planEntry, error) {
+	ext := p.Ext()
+	if cfg.BuildMode != "" {
+		if err := validateBuildMode(cfg.BuildMode, p); err != nil {
+			return planEntry{}, err
+		}
+		ext = buildModeExt(cfg.BuildMode, p)
+	}
+	outPath := ArtifactPath(cfg, p, ext)
+
+	env, cgo, err := assembleBaseEnv(cfg, p)
+	if err != nil {
+		return planEntry{}, err
+	}
+	if cgo && p.OS == "android" {
+		if ndk := utils.FindNDK(); ndk != "" {
+			api := cfg.AndroidAPI
+			if api <= 0 {
+				api = defaultAndroidAPI
+			}
+			if ndkEnv, err := utils.SetupNDKEnvironment(nil, ndk, p.Arch, api); err == nil {
+				env.Apply(ndkEnv)
+			}
+		}
+	}
+
+	args, err := assembleBuildArgs(cfg, p, cgo, outPath)
+	if err != nil {
+		return planEntry{}, err
+	}
+	return planEntry{Target: p.String(), Output: outPath,
+		Command: append([]string{"go"}, args...), Env: env.Overrides()}, nil
+}
+
+// PrintPlan 输出整个矩阵的构建计划，format 为 table 或 json。
+// 用于在不执行的情况下核对 NDK/CGO 等环境组合。
+func PrintPlan(cfg *Config, format string) error {
+	tasks := expandTasks(cfg)
+	var entries []planEntry
+	for _, t := range tasks {
+		variant := cfg
+		if t.suffix != "" || t.source != "" {
+			v := *cfg
+			if t.source != "" {
+				v.Source = t.source
+				v.Name = t.name
+				v.OutputDir = filepath.Join(cfg.OutputDir, t.name)
+			}
+			if t.suffix != "" {
+				v.Tags = t.tags
+				v.Name = v.Name + "_" + t.suffix
+			}
+			variant = &v
+		}
+		entry, err := planTarget(variant, t.platform)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+	if format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+	fmt.Printf("🧪 构建计划 (%d 个目标，不执行):\n", len(entries))
+	for _, e := range entries {
+		fmt.Printf("\n%s\n", e.Target)
+		fmt.Printf("  $ %s\n", strings.Join(e.Command, " "))
+		for _, kv := range e.Env {
+			fmt.Printf("    %s\n", kv)
+		}
+	}
+	return nil
+}
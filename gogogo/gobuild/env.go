@@ -0,0 +1,63 @@
+package gobuild
+
+import (
+	"os"
+	"strings"
+)
+
+// envSet 构建环境组合器：键级幂等写入，后写覆盖先写，
+// 从根源上杜绝 CGO_ENABLED=0 与 =1 同时出现在 cmd.Env 里的情况
+type envSet struct {
+	// order 覆盖项的首次写入顺序
+	order []string
+	// values 覆盖项的当前值
+	values map[string]string
+	// base 继承的宿主环境
+	base []string
+}
+
+// newEnvSet 以宿主环境为底创建组合器
+func newEnvSet() *envSet {
+	return &envSet{values: map[string]string{}, base: os.Environ()}
+}
+
+// Set 写入单个键，重复写入只更新值不改变顺序
+func (e *envSet) Set(key, value string) {
+	if _, ok := e.values[key]; !ok {
+		e.order = append(e.order, key)
+	}
+	e.values[key] = value
+}
+
+// Apply 批量写入 KEY=VALUE 形式的键值对
+func (e *envSet) Apply(pairs []string) {
+	for _, kv := range pairs {
+		if key, value, ok := strings.Cut(kv, "="); ok {
+			e.Set(key, value)
+		}
+	}
+}
+
+// Overrides 返回全部覆盖项 (按首次写入顺序)，用于详细输出与报告
+func (e *envSet) Overrides() []string {
+	pairs := make([]string, 0, len(e.order))
+	for _, key := range e.order {
+		pairs = append(pairs, key+"="+e.values[key])
+	}
+	return pairs
+}
+
+// Environ 返回传给 exec.Cmd 的完整环境：
+// 宿主环境中被覆盖的键剔除后追加覆盖项，每个键只出现一次
+func (e *envSet) Environ() []string {
+	env := make([]string, 0, len(e.base)+len(e.order))
+	for _, kv := range e.base {
+		if key, _, ok := strings.Cut(kv, "="); ok {
+			if _, overridden := e.values[key]; overridden {
+				continue
+			}
+		}
+		env = append(env, kv)
+	}
+	return append(env, e.Overrides()...)
+}
@@ -0,0 +1,28 @@
+package gobuild
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEnvSetLastWriteWins(t *testing.T) {
+	env := newEnvSet()
+	env.Set("CGO_ENABLED", "1")
+	env.Apply([]string{"CC=clang", "CGO_ENABLED=0"})
+
+	count := 0
+	for _, kv := range env.Environ() {
+		if strings.HasPrefix(kv, "CGO_ENABLED=") {
+			count++
+			if kv != "CGO_ENABLED=0" {
+				t.Errorf("后写应覆盖先写: %s", kv)
+			}
+		}
+	}
+	if count != 1 {
+		t.Errorf("CGO_ENABLED 出现 %d 次，应恰好一次", count)
+	}
+	if got := env.Overrides(); len(got) != 2 || got[0] != "CGO_ENABLED=0" || got[1] != "CC=clang" {
+		t.Errorf("Overrides 应保序去重: %v", got)
+	}
+}
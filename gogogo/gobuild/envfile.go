@@ -0,0 +1,62 @@
+package gobuild
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// loadTargetEnv 读取 env/<goos>.env 并返回其中的 KEY=VALUE 列表，
+// 仅对匹配的目标系统生效。文件不存在时返回 nil，不视为错误。
+// 支持 # 注释与空行，值两侧的引号会被剥掉。
+func loadTargetEnv(cfg *Config, goos string) ([]string, error) {
+	path := filepath.Join(sourceDir(cfg), "env", goos+".env")
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var pairs []string
+	scanner := bufio.NewScanner(f)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("%s:%d: 不是 KEY=VALUE 格式: %q", path, lineno, line)
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		pairs = append(pairs, strings.TrimSpace(key)+"="+value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(pairs) > 0 {
+		utils.Logger.Info("envfile.loaded", "path", path, "entries", len(pairs))
+	}
+	return pairs, nil
+}
+
+// sourceDir 返回源路径对应的本地目录；
+// 包导入路径 (非 ./ 开头且不存在于磁盘) 回退为当前目录
+func sourceDir(cfg *Config) string {
+	if cfg.Source == "" {
+		return "."
+	}
+	if info, err := os.Stat(cfg.Source); err == nil && info.IsDir() {
+		return cfg.Source
+	}
+	return "."
+}
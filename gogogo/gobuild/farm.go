@@ -0,0 +1,158 @@
+package gobuild
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// WorkerInfo worker 节点的能力描述
+type WorkerInfo struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+// ServeWorker 以 farm worker 身份监听：
+// GET /info 报告能力，POST /build 接收源码 tar.gz 构建并回传产物 tar.gz
+func ServeWorker(listen string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(WorkerInfo{OS: runtime.GOOS, Arch: runtime.GOARCH})
+	})
+	mux.HandleFunc("/build", handleWorkerBuild)
+	fmt.Printf("👷 farm worker 监听 %s (%s/%s)\n", listen, runtime.GOOS, runtime.GOARCH)
+	return http.ListenAndServe(listen, mux)
+}
+
+func handleWorkerBuild(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	q := r.URL.Query()
+	workDir, err := os.MkdirTemp("", "gogogo-farm-*")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer os.RemoveAll(workDir)
+	if err := untar(r.Body, workDir); err != nil {
+		http.Error(w, "解包源码失败: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	platforms, err := platform.Parse(q.Get("platforms"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// 工作目录经 Config 注入而不是 os.Chdir：
+	// 进程 cwd 是全局的，并发的 /build 请求会互相串源码树
+	cfg := &Config{
+		Source:    ".",
+		Dir:       workDir,
+		OutputDir: filepath.Join(workDir, "build"),
+		Name:      q.Get("name"),
+		Platforms: platforms,
+		Ldflags:   q.Get("ldflags"),
+		Tags:      q.Get("tags"),
+		Parallel:  runtime.NumCPU(),
+		Retry:     DefaultRetryPolicy,
+	}
+	_, buildErr := BuildWithProgress(cfg)
+	utils.Logger.Info("farm.worker_build",
+		"platforms", q.Get("platforms"), "success", buildErr == nil)
+	if buildErr != nil {
+		http.Error(w, buildErr.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/gzip")
+	if err := tarDir(workDir+"/build", w); err != nil {
+		utils.Logger.Error("farm.artifact_stream_failed", "error", err.Error())
+	}
+}
+
+// BuildFarm 协调者模式：按能力把目标分发给多个 worker 并合并产物。
+// darwin/ios 目标只派给 darwin worker，其余目标轮询分配。
+func BuildFarm(cfg *Config, workers []string) error {
+	infos := make(map[string]WorkerInfo)
+	client := &http.Client{Timeout: 10 * time.Second}
+	for _, addr := range workers {
+		resp, err := client.Get("http://" + addr + "/info")
+		if err != nil {
+			return fmt.Errorf("worker %s 不可达: %w", addr, err)
+		}
+		var info WorkerInfo
+		err = json.NewDecoder(resp.Body).Decode(&info)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("worker %s 能力信息无效: %w", addr, err)
+		}
+		infos[addr] = info
+	}
+
+	// 分配目标
+	assignment := make(map[string][]string)
+	rr := 0
+	for _, p := range cfg.Platforms {
+		addr := ""
+		if p.OS == "darwin" || p.OS == "ios" {
+			for a, info := range infos {
+				if info.OS == "darwin" {
+					addr = a
+					break
+				}
+			}
+			if addr == "" {
+				return fmt.Errorf("目标 %s 需要 darwin worker，当前没有", p)
+			}
+		} else {
+			addr = workers[rr%len(workers)]
+			rr++
+		}
+		assignment[addr] = append(assignment[addr], p.String())
+	}
+
+	// 打包一次源码
+	var src bytes.Buffer
+	if err := tarDir(".", &src, cfg.OutputDir, ".git"); err != nil {
+		return fmt.Errorf("打包源码失败: %w", err)
+	}
+
+	for addr, targets := range assignment {
+		fmt.Printf("🏭 分发 %d 个目标到 %s: %s\n", len(targets), addr, strings.Join(targets, ","))
+		query := url.Values{}
+		query.Set("platforms", strings.Join(targets, ","))
+		query.Set("name", cfg.Name)
+		query.Set("ldflags", cfg.Ldflags)
+		query.Set("tags", cfg.Tags)
+		buildURL := "http://" + addr + "/build?" + query.Encode()
+		resp, err := http.Post(buildURL, "application/gzip", bytes.NewReader(src.Bytes()))
+		if err != nil {
+			return fmt.Errorf("worker %s 构建请求失败: %w", addr, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			var msg bytes.Buffer
+			msg.ReadFrom(resp.Body)
+			resp.Body.Close()
+			return fmt.Errorf("worker %s 构建失败: %s", addr, msg.String())
+		}
+		err = untar(resp.Body, cfg.OutputDir)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("合并 %s 产物失败: %w", addr, err)
+		}
+	}
+	fmt.Printf("🏭 farm 构建完成，产物已合并到 %s/\n", cfg.OutputDir)
+	return nil
+}
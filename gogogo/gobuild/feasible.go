@@ -0,0 +1,45 @@
+package gobuild
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// InfeasibleReason 判断当前宿主是否可证明无法构建该目标，
+// 可以构建 (或无法断言) 时返回空串。只收录确定性的结论，
+// 拿不准的目标照常进构建队列，由构建本身给出真实错误
+func InfeasibleReason(cfg *Config, p platform.Platform) string {
+	if !needsCGO(cfg, p.OS) && !cfg.CGO {
+		return ""
+	}
+	switch p.OS {
+	case "ios":
+		if runtime.GOOS != "darwin" {
+			return fmt.Sprintf("ios CGO 构建只能在 macOS 上进行 (当前 %s)", runtime.GOOS)
+		}
+	case "android":
+		if utils.FindNDK() == "" {
+			return "未找到 Android NDK (设置 ANDROID_NDK_HOME)"
+		}
+	}
+	return ""
+}
+
+// FilterFeasible 按宿主能力预筛目标，返回保留的平台与
+// "目标: 原因" 形式的剔除说明。-p all 配合 --feasible-only 使用，
+// 把几十条构建中途的逐个失败换成开场的一张清单
+func FilterFeasible(cfg *Config, platforms []platform.Platform) ([]platform.Platform, []string) {
+	var kept []platform.Platform
+	var dropped []string
+	for _, p := range platforms {
+		if reason := InfeasibleReason(cfg, p); reason != "" {
+			dropped = append(dropped, fmt.Sprintf("%s: %s", p, reason))
+			continue
+		}
+		kept = append(kept, p)
+	}
+	return kept, dropped
+}
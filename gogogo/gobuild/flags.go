@@ -0,0 +1,55 @@
+package gobuild
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SplitFlags 把一条 flag 字符串按 shell 规则切分为参数列表，
+// 支持单引号、双引号与反斜杠转义，
+// 如 `-gcflags=all="-N -l" -trimpath` → ["-gcflags=all=-N -l", "-trimpath"]。
+// GOFLAGS 环境变量无需经过此函数：构建环境继承自进程环境，go 命令自行处理。
+func SplitFlags(s string) ([]string, error) {
+	var args []string
+	var cur strings.Builder
+	inSingle, inDouble, escaped, started := false, false, false, false
+
+	flush := func() {
+		if started {
+			args = append(args, cur.String())
+			cur.Reset()
+			started = false
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			started = true
+			escaped = false
+		case r == '\\' && !inSingle:
+			escaped = true
+			started = true
+		case r == '\'' && !inDouble:
+			inSingle = !inSingle
+			started = true
+		case r == '"' && !inSingle:
+			inDouble = !inDouble
+			started = true
+		case (r == ' ' || r == '\t') && !inSingle && !inDouble:
+			flush()
+		default:
+			cur.WriteRune(r)
+			started = true
+		}
+	}
+	if inSingle || inDouble {
+		return nil, fmt.Errorf("引号未闭合: %q", s)
+	}
+	if escaped {
+		return nil, fmt.Errorf("转义符后缺少字符: %q", s)
+	}
+	flush()
+	return args, nil
+}
@@ -0,0 +1,32 @@
+package gobuild
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitFlags(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"-trimpath", []string{"-trimpath"}},
+		{`-gcflags=all=-l -trimpath`, []string{"-gcflags=all=-l", "-trimpath"}},
+		{`-gcflags=all="-N -l"`, []string{"-gcflags=all=-N -l"}},
+		{`-ldflags '-s -w'`, []string{"-ldflags", "-s -w"}},
+		{`a\ b c`, []string{"a b", "c"}},
+	}
+	for _, c := range cases {
+		got, err := SplitFlags(c.in)
+		if err != nil {
+			t.Fatalf("SplitFlags(%q) error: %v", c.in, err)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SplitFlags(%q) = %#v, want %#v", c.in, got, c.want)
+		}
+	}
+	if _, err := SplitFlags(`"unclosed`); err == nil {
+		t.Error("未闭合引号应当报错")
+	}
+}
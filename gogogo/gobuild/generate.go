@@ -0,0 +1,24 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// runGenerate 在构建矩阵启动前执行一次 go generate ./...，
+// 返回完整输出供写入构建报告
+func runGenerate(cfg *Config) (string, error) {
+	fmt.Println("⚙️  运行 go generate ./...")
+	cmd := exec.Command("go", "generate", "./...")
+	cmd.Dir = cfg.Source
+	cmd.Env = os.Environ()
+	out, err := cmd.CombinedOutput()
+	utils.Logger.Info("generate.run", "success", err == nil, "output", string(out))
+	if err != nil {
+		return string(out), fmt.Errorf("go generate 失败: %w\n%s", err, out)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,64 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// hookEnv 构造描述当前目标的 GOGOGO_* 环境变量
+func hookEnv(cfg *Config, p platform.Platform, outputPath, status string) []string {
+	return append(os.Environ(),
+		"GOGOGO_TARGET_OS="+p.OS,
+		"GOGOGO_TARGET_ARCH="+p.Arch,
+		"GOGOGO_NAME="+cfg.Name,
+		"GOGOGO_OUTPUT_PATH="+outputPath,
+		"GOGOGO_OUTPUT_DIR="+cfg.OutputDir,
+		"GOGOGO_STATUS="+status,
+	)
+}
+
+// runHooks 依次通过 shell 执行钩子命令，任一失败立即返回错误
+func runHooks(hooks []string, env []string, stage string, p platform.Platform) error {
+	for _, hook := range hooks {
+		var cmd *exec.Cmd
+		if runtime.GOOS == "windows" {
+			cmd = exec.Command("cmd", "/C", hook)
+		} else {
+			cmd = exec.Command("sh", "-c", hook)
+		}
+		cmd.Env = env
+		out, err := cmd.CombinedOutput()
+		utils.Logger.Info("hook.run",
+			"stage", stage, "platform", p.String(), "command", hook, "success", err == nil)
+		if err != nil {
+			return fmt.Errorf("%s hook 失败 (%s): %w\n%s", stage, hook, err, out)
+		}
+	}
+	return nil
+}
+
+// runPreHooks 构建前钩子，状态固定为 pending
+func runPreHooks(cfg *Config, p platform.Platform, outputPath string) error {
+	return runHooks(cfg.PreHooks, hookEnv(cfg, p, outputPath, "pending"), "pre", p)
+}
+
+// runPostHooks 构建后钩子，状态为 success/failed/skipped
+func runPostHooks(cfg *Config, res *Result) error {
+	status := "success"
+	switch {
+	case res.Skipped:
+		status = "skipped"
+	case res.Err != nil:
+		status = "failed"
+	}
+	env := hookEnv(cfg, res.Platform, res.OutputPath, status)
+	if res.AndroidAPI > 0 {
+		env = append(env, fmt.Sprintf("GOGOGO_ANDROID_API=%d", res.AndroidAPI))
+	}
+	return runHooks(cfg.PostHooks, env, "post", res.Platform)
+}
@@ -0,0 +1,55 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// resolveBuildVersion 推导本次构建的版本号：
+// 优先 git describe (标签/短 sha/-dirty)，非 git 仓库时退化为时间戳
+func resolveBuildVersion(cfg *Config) string {
+	cmd := exec.Command("git", "-C", sourceDir(cfg), "describe", "--tags", "--always", "--dirty")
+	if out, err := cmd.Output(); err == nil {
+		if v := strings.TrimSpace(string(out)); v != "" {
+			return v
+		}
+	}
+	return time.Now().Format("20060102-150405")
+}
+
+// ApplyOutputLayout 按 --output-layout 调整输出目录：
+// versioned 时产物落在 <out>/<版本>/ 下，历史构建互不覆盖，
+// 配合 gogogo clean 的保留策略使用
+func ApplyOutputLayout(cfg *Config) error {
+	switch cfg.OutputLayout {
+	case "", "flat":
+		return nil
+	case "versioned":
+		if cfg.Version == "" {
+			cfg.Version = resolveBuildVersion(cfg)
+		}
+		cfg.OutputDir = filepath.Join(cfg.OutputDir, cfg.Version)
+		return nil
+	default:
+		return fmt.Errorf("未知的输出布局: %s (支持 flat/versioned)", cfg.OutputLayout)
+	}
+}
+
+// updateLatestLink 把 <out>/../latest 指向本次的版本目录，
+// 不支持符号链接的文件系统上仅提示不中断
+func updateLatestLink(cfg *Config) {
+	if cfg.OutputLayout != "versioned" {
+		return
+	}
+	link := filepath.Join(filepath.Dir(cfg.OutputDir), "latest")
+	_ = os.Remove(link)
+	if err := os.Symlink(filepath.Base(cfg.OutputDir), link); err != nil {
+		fmt.Printf("⚠️  更新 latest 链接失败: %v\n", err)
+		return
+	}
+	fmt.Printf("🔗 latest -> %s\n", filepath.Base(cfg.OutputDir))
+}
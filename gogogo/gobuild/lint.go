@@ -0,0 +1,37 @@
+package gobuild
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// runLint 在矩阵启动前运行一次静态检查 (--lint vet,staticcheck)，
+// 有问题立即失败，避免编译完几十个平台才发现 vet 错误
+func runLint(cfg *Config) (string, error) {
+	var combined string
+	for _, linter := range cfg.Lint {
+		var cmd *exec.Cmd
+		switch linter {
+		case "vet":
+			cmd = exec.Command("go", "vet", "./...")
+		case "staticcheck":
+			if _, err := exec.LookPath("staticcheck"); err != nil {
+				return combined, fmt.Errorf("未找到 staticcheck，请先安装: go install honnef.co/go/tools/cmd/staticcheck@latest")
+			}
+			cmd = exec.Command("staticcheck", "./...")
+		default:
+			return combined, fmt.Errorf("未知的检查器: %q (支持 vet/staticcheck)", linter)
+		}
+		fmt.Printf("🔍 运行 %s\n", linter)
+		cmd.Dir = cfg.Source
+		out, err := cmd.CombinedOutput()
+		combined += string(out)
+		utils.Logger.Info("lint.run", "linter", linter, "success", err == nil)
+		if err != nil {
+			return combined, fmt.Errorf("%s 检查未通过:\n%s", linter, out)
+		}
+	}
+	return combined, nil
+}
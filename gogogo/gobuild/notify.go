@@ -0,0 +1,105 @@
+package gobuild
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// notificationPayload 发给 webhook 的 JSON 摘要
+type notificationPayload struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Succeeded int     `json:"succeeded"`
+	Failed    int     `json:"failed"`
+	Skipped   int     `json:"skipped"`
+	Total     int     `json:"total"`
+	Duration  float64 `json:"duration_seconds"`
+}
+
+// sendNotifications 按 --notify 配置在构建结束后发送通知，
+// 支持 "webhook:<url>" 与 "desktop"，通知失败不影响构建结果
+func sendNotifications(cfg *Config, report *Report) {
+	if len(cfg.Notify) == 0 {
+		return
+	}
+	skipped := 0
+	for _, res := range report.Results {
+		if res.Skipped {
+			skipped++
+		}
+	}
+	payload := notificationPayload{
+		Name:      cfg.Name,
+		Status:    "success",
+		Succeeded: report.Succeeded(),
+		Failed:    report.Failed(),
+		Skipped:   skipped,
+		Total:     len(report.Results),
+		Duration:  report.Duration.Seconds(),
+	}
+	if payload.Failed > 0 {
+		payload.Status = "failed"
+	}
+	summary := fmt.Sprintf("gogogo %s: 成功 %d / 失败 %d / 跳过 %d，耗时 %.1fs",
+		cfg.Name, payload.Succeeded, payload.Failed, payload.Skipped, payload.Duration)
+
+	for _, target := range cfg.Notify {
+		var err error
+		switch {
+		case strings.HasPrefix(target, "webhook:"):
+			err = notifyWebhook(strings.TrimPrefix(target, "webhook:"), payload)
+		case target == "desktop":
+			err = notifyDesktop(summary, payload.Status)
+		default:
+			err = fmt.Errorf("未知的通知方式: %q", target)
+		}
+		if err != nil {
+			fmt.Printf("⚠️  通知发送失败 (%s): %v\n", target, err)
+		}
+		utils.Logger.Info("notify.sent", "target", target, "success", err == nil)
+	}
+}
+
+func notifyWebhook(url string, payload notificationPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook 返回 %s", resp.Status)
+	}
+	return nil
+}
+
+func notifyDesktop(summary, status string) error {
+	switch runtime.GOOS {
+	case "linux", "android":
+		if _, err := exec.LookPath("notify-send"); err != nil {
+			return fmt.Errorf("未找到 notify-send")
+		}
+		icon := "dialog-information"
+		if status == "failed" {
+			icon = "dialog-error"
+		}
+		return exec.Command("notify-send", "-i", icon, "gogogo", summary).Run()
+	case "darwin":
+		script := fmt.Sprintf(`display notification %q with title "gogogo"`, summary)
+		return exec.Command("osascript", "-e", script).Run()
+	default:
+		return fmt.Errorf("当前系统不支持桌面通知")
+	}
+}
@@ -0,0 +1,60 @@
+package gobuild
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// hasVendorDir 判断源码目录是否带 vendor 目录
+func hasVendorDir(source string) bool {
+	info, err := os.Stat(filepath.Join(source, "vendor"))
+	return err == nil && info.IsDir()
+}
+
+// VerifyOffline 在离线模式启动矩阵前校验依赖完整性：
+// 有 vendor 目录时直接通过，否则用 GOPROXY=off 检查模块缓存，
+// 缺失时提前失败并列出缺少的模块
+func VerifyOffline(source string) error {
+	if hasVendorDir(source) {
+		return nil
+	}
+	cmd := exec.Command("go", "mod", "download", "-json")
+	cmd.Dir = source
+	cmd.Env = append(os.Environ(), "GOPROXY=off", "GOFLAGS=-mod=mod")
+	out, _ := cmd.Output()
+
+	var missing []string
+	dec := json.NewDecoder(strings.NewReader(string(out)))
+	for dec.More() {
+		var mod struct {
+			Path    string `json:"Path"`
+			Version string `json:"Version"`
+			Error   string `json:"Error"`
+		}
+		if err := dec.Decode(&mod); err != nil {
+			break
+		}
+		if mod.Error != "" {
+			missing = append(missing, fmt.Sprintf("  %s@%s: %s", mod.Path, mod.Version, mod.Error))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("离线模式下模块缓存不完整，缺少 %d 个模块:\n%s\n"+
+			"请先在有网络的环境执行 go mod download 或 go mod vendor",
+			len(missing), strings.Join(missing, "\n"))
+	}
+	return nil
+}
+
+// offlineEnv 返回离线构建需要追加的环境变量
+func offlineEnv(source string) []string {
+	env := []string{"GOPROXY=off", "GOFLAGS=-mod=mod"}
+	if hasVendorDir(source) {
+		env[1] = "GOFLAGS=-mod=vendor"
+	}
+	return env
+}
@@ -0,0 +1,135 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// PackageMeta 线性包 (deb/rpm/apk) 的元数据
+type PackageMeta struct {
+	// Name 包名，默认同二进制名
+	Name string
+	// Version 包版本，默认从 git describe 推导 (去掉 v 前缀)
+	Version string
+	// Maintainer / Description / License / Homepage 包描述字段
+	Maintainer  string
+	Description string
+	License     string
+	Homepage    string
+	// SystemdUnit 随包安装的 systemd unit 文件路径，空串不包含
+	SystemdUnit string
+}
+
+// pkgFormats 支持的包格式
+var pkgFormats = map[string]bool{"deb": true, "rpm": true, "apk": true}
+
+// PackageArtifacts 把输出目录里的 linux 产物打成 deb/rpm/apk 包。
+// 打包交给 nfpm 完成 (按目标生成配置后逐格式调用)，
+// 产物写到 <out>/packages/
+func PackageArtifacts(cfg *Config, formats []string, meta PackageMeta) error {
+	if _, err := exec.LookPath("nfpm"); err != nil {
+		return fmt.Errorf("未找到 nfpm，请先安装: go install github.com/goreleaser/nfpm/v2/cmd/nfpm@latest")
+	}
+	for _, f := range formats {
+		if !pkgFormats[f] {
+			return fmt.Errorf("不支持的包格式: %s (支持 deb/rpm/apk)", f)
+		}
+	}
+	if meta.Name == "" {
+		meta.Name = cfg.Name
+	}
+	if meta.Version == "" {
+		meta.Version = strings.TrimPrefix(resolveBuildVersion(cfg), "v")
+	}
+	if meta.Description == "" {
+		meta.Description = meta.Name + " (packaged by gogogo)"
+	}
+
+	linuxDir := filepath.Join(cfg.OutputDir, "linux")
+	entries, err := os.ReadDir(linuxDir)
+	if err != nil {
+		return fmt.Errorf("没有 linux 产物可打包 (%s): %w", linuxDir, err)
+	}
+	pkgDir := filepath.Join(cfg.OutputDir, "packages")
+	if err := os.MkdirAll(pkgDir, 0o755); err != nil {
+		return err
+	}
+	packed := 0
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		arch, ok := strings.CutSuffix(e.Name(), "-"+cfg.Name)
+		if !ok {
+			continue
+		}
+		binary := filepath.Join(linuxDir, e.Name(), cfg.Name)
+		if _, err := os.Stat(binary); err != nil {
+			continue
+		}
+		if err := nfpmPackage(binary, arch, pkgDir, formats, meta); err != nil {
+			return err
+		}
+		packed++
+	}
+	if packed == 0 {
+		return fmt.Errorf("在 %s 下没有找到可打包的二进制，请先构建 linux 目标", linuxDir)
+	}
+	fmt.Printf("✅ 已为 %d 个架构生成 %s 包 → %s\n", packed, strings.Join(formats, "/"), pkgDir)
+	return nil
+}
+
+// nfpmPackage 为单个架构的二进制生成所有请求格式的包
+func nfpmPackage(binary, arch, pkgDir string, formats []string, meta PackageMeta) error {
+	cfgFile, err := writeNfpmConfig(binary, arch, meta)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cfgFile)
+	for _, format := range formats {
+		cmd := exec.Command("nfpm", "package", "-f", cfgFile, "-p", format, "-t", pkgDir)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("nfpm 打包 %s/%s 失败: %v\n%s", arch, format, err, out)
+		}
+		fmt.Printf("📦 %s (%s, %s)\n", meta.Name, arch, format)
+	}
+	return nil
+}
+
+// writeNfpmConfig 生成单架构的 nfpm 配置文件 (临时文件，调用方负责删除)
+func writeNfpmConfig(binary, arch string, meta PackageMeta) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "name: %s\n", meta.Name)
+	fmt.Fprintf(&b, "arch: %s\n", arch)
+	fmt.Fprintf(&b, "version: %s\n", meta.Version)
+	fmt.Fprintf(&b, "description: %s\n", meta.Description)
+	if meta.Maintainer != "" {
+		fmt.Fprintf(&b, "maintainer: %s\n", meta.Maintainer)
+	}
+	if meta.License != "" {
+		fmt.Fprintf(&b, "license: %s\n", meta.License)
+	}
+	if meta.Homepage != "" {
+		fmt.Fprintf(&b, "homepage: %s\n", meta.Homepage)
+	}
+	b.WriteString("contents:\n")
+	fmt.Fprintf(&b, "  - src: %s\n    dst: /usr/bin/%s\n", binary, meta.Name)
+	if meta.SystemdUnit != "" {
+		fmt.Fprintf(&b, "  - src: %s\n    dst: /usr/lib/systemd/system/%s\n",
+			meta.SystemdUnit, filepath.Base(meta.SystemdUnit))
+	}
+
+	f, err := os.CreateTemp("", "gogogo-nfpm-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.WriteString(b.String()); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
@@ -0,0 +1,108 @@
+package gobuild
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// PluginRequest 通过 stdin 传给插件的 JSON 负载
+type PluginRequest struct {
+	Stage      string `json:"stage"` // 目前固定为 post-build
+	OS         string `json:"os"`
+	Arch       string `json:"arch"`
+	Name       string `json:"name"`
+	OutputPath string `json:"output_path"`
+	OutputDir  string `json:"output_dir"`
+}
+
+// PluginResponse 插件通过 stdout 返回的 JSON 结果
+type PluginResponse struct {
+	OK      bool   `json:"ok"`
+	Message string `json:"message,omitempty"`
+	// NewPath 插件替换/重命名产物后的新路径
+	NewPath string `json:"new_path,omitempty"`
+}
+
+// discoverPlugins 返回插件目录下所有可执行文件，按名称排序保证执行顺序稳定
+func discoverPlugins(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var plugins []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		if runtime.GOOS != "windows" && info.Mode()&0o111 == 0 {
+			continue
+		}
+		plugins = append(plugins, path)
+	}
+	sort.Strings(plugins)
+	return plugins
+}
+
+// runPlugins 对单个构建产物依次执行全部插件（JSON over stdin/stdout）
+func runPlugins(cfg *Config, res *Result) error {
+	dir := cfg.PluginsDir
+	if dir == "" {
+		dir = "gogogo.plugins"
+	}
+	plugins := discoverPlugins(dir)
+	if len(plugins) == 0 {
+		return nil
+	}
+
+	for _, plugin := range plugins {
+		req := PluginRequest{
+			Stage:      "post-build",
+			OS:         res.Platform.OS,
+			Arch:       res.Platform.Arch,
+			Name:       cfg.Name,
+			OutputPath: res.OutputPath,
+			OutputDir:  cfg.OutputDir,
+		}
+		payload, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+
+		cmd := exec.Command(plugin)
+		cmd.Stdin = bytes.NewReader(payload)
+		var stdout bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("插件 %s 执行失败 (%s): %w", filepath.Base(plugin), res.Platform, err)
+		}
+
+		var resp PluginResponse
+		if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+			return fmt.Errorf("插件 %s 返回了无效的 JSON: %w", filepath.Base(plugin), err)
+		}
+		utils.Logger.Info("plugin.run",
+			"plugin", filepath.Base(plugin), "platform", res.Platform.String(),
+			"ok", resp.OK, "message", resp.Message)
+		if !resp.OK {
+			return fmt.Errorf("插件 %s 处理失败: %s", filepath.Base(plugin), resp.Message)
+		}
+		if resp.NewPath != "" {
+			res.OutputPath = resp.NewPath
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package gobuild
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"sync"
+)
+
+// cgroupWarnOnce 缺少 systemd-run 的提示只打一次
+var cgroupWarnOnce sync.Once
+
+// buildCommand 创建 go build 进程；--low-priority 时用
+// nice/ionice 降低 CPU 与 IO 优先级，linux 上可再经
+// systemd-run 套一层带内存上限的 cgroup
+func buildCommand(cfg *Config, args []string) *exec.Cmd {
+	argv := append([]string{"go"}, args...)
+	if cfg.LowPriority {
+		argv = lowPriorityArgv(cfg, argv)
+	}
+	return exec.Command(argv[0], argv[1:]...)
+}
+
+// lowPriorityArgv 按可用工具逐层包装命令行，缺哪个就跳过哪个
+func lowPriorityArgv(cfg *Config, argv []string) []string {
+	if runtime.GOOS == "linux" && cfg.CgroupMemory != "" {
+		if _, err := exec.LookPath("systemd-run"); err == nil {
+			argv = append([]string{"systemd-run", "--user", "--scope", "--quiet",
+				"-p", "MemoryMax=" + cfg.CgroupMemory}, argv...)
+		} else {
+			cgroupWarnOnce.Do(func() {
+				fmt.Println("⚠️  未找到 systemd-run，--cgroup-memory 不生效")
+			})
+		}
+	}
+	if runtime.GOOS == "linux" {
+		if _, err := exec.LookPath("ionice"); err == nil {
+			argv = append([]string{"ionice", "-c", "3"}, argv...)
+		}
+	}
+	if _, err := exec.LookPath("nice"); err == nil {
+		argv = append([]string{"nice", "-n", "19"}, argv...)
+	}
+	return argv
+}
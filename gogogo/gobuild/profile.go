@@ -0,0 +1,56 @@
+package gobuild
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Profile 一组预设的编译选项，在用户自己的 ldflags/go-flags 之前生效
+type Profile struct {
+	// Ldflags 追加在用户 ldflags 之前
+	Ldflags string
+	// GoFlags 追加的 go build flag (shell 语义)
+	GoFlags string
+}
+
+// profiles 可用的构建预设；内置 release/debug，
+// 用户配置文件可通过 RegisterProfile 覆盖或新增
+var profiles = map[string]Profile{
+	"release": {Ldflags: "-s -w", GoFlags: "-trimpath"},
+	"debug":   {GoFlags: `-gcflags=all="-N -l"`},
+}
+
+// RegisterProfile 注册或覆盖一个自定义预设 (由用户配置加载时调用)
+func RegisterProfile(name string, p Profile) {
+	profiles[name] = p
+}
+
+// ProfileNames 返回全部可用预设名
+func ProfileNames() []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ApplyProfile 将 --profile 指定的预设合并进配置：
+// 预设 ldflags 在前，用户 ldflags 在后（后者可覆盖前者）
+func ApplyProfile(cfg *Config, name string) error {
+	if name == "" {
+		return nil
+	}
+	p, ok := profiles[name]
+	if !ok {
+		return fmt.Errorf("未知的构建预设: %q (可用: %s)", name, strings.Join(ProfileNames(), ", "))
+	}
+	if p.Ldflags != "" {
+		cfg.Ldflags = strings.TrimSpace(p.Ldflags + " " + cfg.Ldflags)
+	}
+	if p.GoFlags != "" {
+		cfg.GoFlags = strings.TrimSpace(p.GoFlags + " " + cfg.GoFlags)
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/events"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/i18n"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+// Report 整个构建矩阵的汇总报告
+type Report struct {
+	Results   []*Result
+	StartTime time.Time
+	Duration  time.Duration
+	// GenerateOutput 构建前 go generate 的输出 (--generate 时)
+	GenerateOutput string
+	// GoVersion 本次构建实际使用的 go 版本
+	GoVersion string
+	// LintOutput 构建前静态检查的输出 (--lint 时)
+	LintOutput string
+}
+
+// Succeeded 返回成功目标数
+func (r *Report) Succeeded() int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Err == nil && !res.Skipped {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed 返回失败目标数，不含配置标注的预期失败
+func (r *Report) Failed() int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Err != nil && !res.XFail {
+			n++
+		}
+	}
+	return n
+}
+
+// XFailed 返回按预期失败的目标数
+func (r *Report) XFailed() int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Err != nil && res.XFail {
+			n++
+		}
+	}
+	return n
+}
+
+// publish 发布流水线事件，未接事件总线时为空操作
+func publish(cfg *Config, ev events.Event) {
+	if cfg.Events != nil {
+		cfg.Events.Publish(ev)
+	}
+}
+
+// finishedEvent 把构建结果折叠成 finished 事件
+func finishedEvent(label string, res *Result) events.Event {
+	ev := events.Event{Type: events.TypeFinished, Target: label, Status: "success",
+		CGOEnv: CGOEnvEcho(res.Env)}
+	switch {
+	case res.Skipped:
+		ev.Status = "skipped"
+	case res.Err != nil && res.XFail:
+		ev.Status = "xfail"
+		ev.Error = res.Err.Error()
+	case res.Err != nil:
+		ev.Status = "failed"
+		ev.Error = res.Err.Error()
+	}
+	return ev
+}
+
+// buildTask 一次构建任务：主包 × 平台 × 标签集
+type buildTask struct {
+	platform platform.Platform
+	// tags 本次使用的 -tags 值
+	tags string
+	// suffix 产物名后缀，标签矩阵展开时非空
+	suffix string
+	// source/name 多主包构建时的包路径与命令名
+	source string
+	name   string
+}
+
+// expandTasks 将主包列表 × 平台列表 × TagsMatrix 展开为任务列表
+func expandTasks(cfg *Config) []buildTask {
+	platforms := orderTargets(cfg.Platforms)
+	sources := cfg.Sources
+	if len(sources) == 0 {
+		sources = []string{cfg.Source}
+	}
+	multi := len(sources) > 1
+
+	tagSets := cfg.TagsMatrix
+	if len(tagSets) == 0 {
+		tagSets = []string{cfg.Tags}
+	}
+
+	var tasks []buildTask
+	for _, src := range sources {
+		for _, p := range platforms {
+			for _, tagSet := range tagSets {
+				t := buildTask{platform: p, tags: tagSet}
+				if len(cfg.TagsMatrix) > 0 {
+					t.suffix = strings.ReplaceAll(strings.TrimSpace(tagSet), " ", "-")
+				}
+				if multi {
+					t.source = src
+					t.name = commandName(src)
+				}
+				tasks = append(tasks, t)
+			}
+		}
+	}
+	return tasks
+}
+
+// label 返回任务在进度输出中的显示名
+func (t buildTask) label() string {
+	label := t.platform.String()
+	if t.name != "" {
+		label = t.name + " " + label
+	}
+	if t.suffix != "" {
+		label += " [" + t.suffix + "]"
+	}
+	return label
+}
+
+// commandName 从包路径推导命令名 (路径末段)
+func commandName(source string) string {
+	base := filepath.Base(strings.TrimSuffix(source, "/"))
+	if base == "." || base == "" {
+		return "main"
+	}
+	return base
+}
+
+// runTask 按任务构建，多主包/标签矩阵任务使用派生配置：
+// 覆盖源路径与产物名，多主包时各命令输出到独立子目录
+func runTask(cfg *Config, t buildTask) *Result {
+	if t.suffix == "" && t.source == "" {
+		return BuildSingle(cfg, t.platform)
+	}
+	variant := *cfg
+	if t.source != "" {
+		variant.Source = t.source
+		variant.Name = t.name
+		variant.OutputDir = filepath.Join(cfg.OutputDir, t.name)
+	}
+	if t.suffix != "" {
+		variant.Tags = t.tags
+		variant.Name = variant.Name + "_" + t.suffix
+	}
+	return BuildSingle(&variant, t.platform)
+}
+
+// BuildWithProgress 并行构建全部目标并实时输出进度
+func BuildWithProgress(cfg *Config) (*Report, error) {
+	if cfg.Offline {
+		if err := VerifyOffline(cfg.Source); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &Report{StartTime: time.Now()}
+	report.GoVersion = resolveGoVersion(cfg)
+	if cfg.GoVersion != "" {
+		fmt.Printf("🧰 使用工具链: %s\n", report.GoVersion)
+	}
+	if len(cfg.Lint) > 0 {
+		out, err := runLint(cfg)
+		report.LintOutput = out
+		if err != nil {
+			return report, err
+		}
+	}
+	if cfg.Generate {
+		out, err := runGenerate(cfg)
+		report.GenerateOutput = out
+		if err != nil {
+			return report, err
+		}
+	}
+	if cfg.WarmCache {
+		if err := warmCache(cfg); err != nil {
+			return report, err
+		}
+	}
+	tasks := expandTasks(cfg)
+	if err := preflightDisk(cfg, len(tasks)); err != nil {
+		return report, err
+	}
+	tracker, alreadyDone := newResumeTracker(cfg)
+	if len(alreadyDone) > 0 {
+		var remaining []buildTask
+		for _, t := range tasks {
+			if alreadyDone[t.label()] {
+				fmt.Printf("⏭️  %-20s 上次已完成 (--resume)\n", t.label())
+			} else {
+				remaining = append(remaining, t)
+			}
+		}
+		tasks = remaining
+		if len(tasks) == 0 {
+			fmt.Println("✅ 所有目标都已完成，无需重建")
+			return report, nil
+		}
+	}
+	total := len(tasks)
+	fmt.Print(i18n.T("build.start", total, cfg.Parallel))
+	if cfg.Cover {
+		fmt.Println("🧪 覆盖率插桩已启用: 运行二进制时设置 GOCOVERDIR=<目录>，" +
+			"收集后用 gogogo coverreport 汇总")
+	}
+
+	var dash *tui
+	if cfg.TUI {
+		labels := make([]string, len(tasks))
+		for i, t := range tasks {
+			labels[i] = t.label()
+		}
+		dash = newTUI(labels)
+		go dash.loop()
+	}
+
+	sched := newScheduler(cfg)
+	go sched.monitorMemory()
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	done := 0
+
+	for _, t := range tasks {
+		publish(cfg, events.Event{Type: events.TypeQueued, Target: t.label()})
+	}
+	for i, t := range tasks {
+		wg.Add(1)
+		go func(i int, t buildTask) {
+			defer wg.Done()
+			label := t.label()
+			if !sched.acquire() {
+				mu.Lock()
+				defer mu.Unlock()
+				done++
+				res := &Result{Platform: t.platform, Skipped: true, SkipReason: i18n.T("build.cancelled")}
+				report.Results = append(report.Results, res)
+				publish(cfg, finishedEvent(label, res))
+				if dash != nil {
+					dash.setStatus(i, tuiSkipped)
+				} else {
+					fmt.Printf("[%d/%d] ⏭️  %-20s %s\n", done, total, label, res.SkipReason)
+				}
+				return
+			}
+			defer sched.release()
+
+			if dash != nil {
+				dash.setStatus(i, tuiBuilding)
+			}
+			publish(cfg, events.Event{Type: events.TypeStarted, Target: label})
+			res := runTask(cfg, t)
+			publish(cfg, finishedEvent(label, res))
+			if res.Err == nil && !res.Skipped {
+				tracker.markDone(label)
+			}
+			if res.Err != nil && !res.XFail && cfg.FailFast {
+				sched.abort()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			done++
+			report.Results = append(report.Results, res)
+			switch {
+			case res.Skipped:
+				if dash != nil {
+					dash.setStatus(i, tuiSkipped)
+				} else {
+					fmt.Printf("[%d/%d] ⏭️  %-20s %s\n", done, total, label, res.SkipReason)
+				}
+			case res.Err != nil && res.XFail:
+				if dash != nil {
+					dash.setStatus(i, tuiSkipped)
+				} else {
+					fmt.Printf("[%d/%d] ⚠️  %-20s 预期失败 (%s)\n", done, total, label, res.XFailReason)
+				}
+			case res.Err != nil:
+				if dash != nil {
+					dash.setStatus(i, tuiFailed)
+				} else {
+					fmt.Printf("[%d/%d] ❌ %-20s %v\n", done, total, label, res.Err)
+				}
+			default:
+				if dash != nil {
+					dash.setStatus(i, tuiOK)
+				} else {
+					fmt.Printf("[%d/%d] ✅ %-20s (%.1fs)\n", done, total, label, res.Duration.Seconds())
+				}
+			}
+		}(i, t)
+	}
+	wg.Wait()
+	sched.abort()
+	if dash != nil {
+		dash.stopLoop()
+	}
+
+	report.Duration = time.Since(report.StartTime)
+	// 各目标已清理自己的 GOTMPDIR，这里收掉空壳目录
+	_ = os.Remove(filepath.Join(cfg.OutputDir, ".gogogo-tmp"))
+	tracker.finish(report)
+	if cfg.ABISplit || cfg.ABIZip {
+		if err := collectABILayout(cfg, report); err != nil {
+			return report, err
+		}
+	}
+	if report.Succeeded() > 0 {
+		updateLatestLink(cfg)
+	}
+	printSummary(cfg, report)
+	sendNotifications(cfg, report)
+	if cfg.Sign != "" && report.Succeeded() > 0 {
+		if err := signArtifacts(cfg, report); err != nil {
+			return report, err
+		}
+	}
+	if report.Failed() > 0 {
+		return report, fmt.Errorf(i18n.T("build.failed_count"), report.Failed())
+	}
+	return report, nil
+}
+
+// printSummary 打印汇总表，包含产物大小
+func printSummary(cfg *Config, report *Report) {
+	fmt.Print(i18n.T("build.summary",
+		report.Succeeded(), report.Failed(), len(report.Results), report.Duration.Seconds()))
+	if n := report.XFailed(); n > 0 {
+		fmt.Printf("⚠️  %d 个目标按预期失败:\n", n)
+		for _, res := range report.Results {
+			if res.Err != nil && res.XFail {
+				fmt.Printf("  %-20s %s\n", res.Platform, res.XFailReason)
+			}
+		}
+	}
+	for _, res := range report.Results {
+		if res.Err != nil || res.Skipped {
+			continue
+		}
+		p := res.Platform
+		artifact := res.OutputPath
+		if artifact == "" {
+			artifact = ArtifactPath(cfg, p, p.Ext())
+		}
+		if info, err := os.Stat(artifact); err == nil {
+			fmt.Printf("  %-20s %8.2f MB\n", p, float64(info.Size())/1024/1024)
+		} else {
+			fmt.Printf("  %-20s %s\n", p, i18n.T("build.file_not_found"))
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseAge 解析保留期限：支持 "7d" 的天数写法，
+// 其余交给 time.ParseDuration ("24h"/"90m")
+func ParseAge(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("无效的期限: %s", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// historyDirs 列出输出目录下的历史构建目录，按修改时间从新到旧排序。
+// latest 等符号链接不算历史目录
+func historyDirs(dir string) ([]os.FileInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var infos []os.FileInfo
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().After(infos[j].ModTime())
+	})
+	return infos, nil
+}
+
+// PruneOutputs 按保留策略删除历史构建目录：
+// keepLast > 0 时仅保留最新 N 个，olderThan > 0 时删除超龄目录，
+// 两个条件满足其一即删。返回删除的目录数
+func PruneOutputs(dir string, keepLast int, olderThan time.Duration) (int, error) {
+	infos, err := historyDirs(dir)
+	if err != nil {
+		return 0, err
+	}
+	cutoff := time.Time{}
+	if olderThan > 0 {
+		cutoff = time.Now().Add(-olderThan)
+	}
+	removed := 0
+	for i, info := range infos {
+		expired := !cutoff.IsZero() && info.ModTime().Before(cutoff)
+		beyond := keepLast > 0 && i >= keepLast
+		if !expired && !beyond {
+			continue
+		}
+		path := filepath.Join(dir, info.Name())
+		if err := os.RemoveAll(path); err != nil {
+			return removed, fmt.Errorf("删除 %s 失败: %w", path, err)
+		}
+		fmt.Printf("🧹 已删除 %s (%s)\n", path, info.ModTime().Format("2006-01-02 15:04"))
+		removed++
+	}
+	return removed, nil
+}
+
+// CleanTransient 清理输出目录下的临时文件：构建日志与断点文件
+func CleanTransient(dir string) error {
+	for _, name := range []string{"logs", ".gogogo-state.json", ".gogogo-tmp"} {
+		path := filepath.Join(dir, name)
+		if _, err := os.Lstat(path); err != nil {
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+		fmt.Printf("🧹 已删除 %s\n", path)
+	}
+	return nil
+}
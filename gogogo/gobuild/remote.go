@@ -0,0 +1,75 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// BuildRemote 在远程机器上执行整个构建：
+// rsync 同步源码 → ssh 远程运行 gogogo → rsync 取回产物。
+// 典型用途是把 ios/darwin CGO 目标交给一台 mac 构建。
+func BuildRemote(cfg *Config, remote string, args []string) error {
+	for _, tool := range []string{"ssh", "rsync"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			return fmt.Errorf("远程构建需要 %s 命令", tool)
+		}
+	}
+
+	remoteDir := ".cache/gogogo/remote/" + cfg.Name
+	fmt.Printf("📡 同步源码到 %s:%s\n", remote, remoteDir)
+	if out, err := exec.Command("ssh", remote, "mkdir", "-p", remoteDir).CombinedOutput(); err != nil {
+		return fmt.Errorf("创建远程目录失败: %w\n%s", err, out)
+	}
+	sync := exec.Command("rsync", "-az", "--delete",
+		"--exclude", cfg.OutputDir+"/", "--exclude", ".git/",
+		"./", remote+":"+remoteDir+"/")
+	if out, err := sync.CombinedOutput(); err != nil {
+		return fmt.Errorf("源码同步失败: %w\n%s", err, out)
+	}
+
+	// 远程重跑同一条命令 (已去除 --remote 本身)
+	remoteCmd := fmt.Sprintf("cd %s && gogogo %s", remoteDir, strings.Join(args, " "))
+	fmt.Printf("📡 远程执行: %s\n", remoteCmd)
+	run := exec.Command("ssh", remote, remoteCmd)
+	run.Stdout = os.Stdout
+	run.Stderr = os.Stderr
+	buildErr := run.Run()
+
+	fmt.Printf("📡 取回产物到 %s/\n", cfg.OutputDir)
+	pull := exec.Command("rsync", "-az",
+		remote+":"+remoteDir+"/"+cfg.OutputDir+"/", cfg.OutputDir+"/")
+	if out, err := pull.CombinedOutput(); err != nil {
+		return fmt.Errorf("取回产物失败: %w\n%s", err, out)
+	}
+	utils.Logger.Info("remote.build", "remote", remote, "success", buildErr == nil)
+	if buildErr != nil {
+		return fmt.Errorf("远程构建失败: %w", buildErr)
+	}
+	return nil
+}
+
+// StripRemoteFlag 从参数列表中去掉 --remote 及其值，
+// 避免远程机器再次触发远程构建
+func StripRemoteFlag(args []string) []string {
+	var out []string
+	skip := false
+	for _, arg := range args {
+		if skip {
+			skip = false
+			continue
+		}
+		if arg == "--remote" {
+			skip = true
+			continue
+		}
+		if strings.HasPrefix(arg, "--remote=") {
+			continue
+		}
+		out = append(out, arg)
+	}
+	return out
+}
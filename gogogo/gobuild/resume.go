@@ -0,0 +1,96 @@
+package gobuild
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// buildState 落盘的矩阵进度，--resume 据此跳过已完成的目标
+type buildState struct {
+	// Hash 本次构建输入的指纹，对不上说明输入变了，断点作废
+	Hash string `json:"hash"`
+	// Done 已成功完成的目标标识
+	Done map[string]bool `json:"done"`
+}
+
+// statePath 进度文件放在输出目录里，随 clean 一起清掉
+func statePath(cfg *Config) string {
+	return filepath.Join(cfg.OutputDir, ".gogogo-state.json")
+}
+
+// stateHash 计算构建输入的指纹：源、产物名与影响产物的全部设置
+func stateHash(cfg *Config) string {
+	var parts []string
+	parts = append(parts, cfg.Source, cfg.Name, cfg.Ldflags, cfg.Tags,
+		cfg.GoFlags, cfg.BuildMode, cfg.GoVersion,
+		fmt.Sprint(cfg.Static), fmt.Sprint(cfg.CGO), fmt.Sprint(cfg.Cover))
+	parts = append(parts, cfg.Sources...)
+	parts = append(parts, cfg.TagsMatrix...)
+	for _, p := range cfg.Platforms {
+		parts = append(parts, p.String())
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// resumeTracker 在构建过程中增量记录完成的目标
+type resumeTracker struct {
+	mu    sync.Mutex
+	path  string
+	state buildState
+}
+
+// newResumeTracker 初始化进度跟踪，--resume 时读取既有断点：
+// 指纹一致返回已完成集合，不一致则丢弃并提示
+func newResumeTracker(cfg *Config) (*resumeTracker, map[string]bool) {
+	t := &resumeTracker{
+		path:  statePath(cfg),
+		state: buildState{Hash: stateHash(cfg), Done: map[string]bool{}},
+	}
+	if !cfg.Resume {
+		return t, nil
+	}
+	data, err := os.ReadFile(t.path)
+	if err != nil {
+		return t, nil
+	}
+	var prev buildState
+	if err := json.Unmarshal(data, &prev); err != nil {
+		return t, nil
+	}
+	if prev.Hash != t.state.Hash {
+		fmt.Println("⚠️  构建输入已变化，忽略上次的断点")
+		return t, nil
+	}
+	t.state.Done = prev.Done
+	return t, prev.Done
+}
+
+// markDone 记录目标完成并立即落盘，崩溃后断点不丢
+func (t *resumeTracker) markDone(label string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.state.Done[label] = true
+	data, err := json.Marshal(t.state)
+	if err != nil {
+		return
+	}
+	if err := os.WriteFile(t.path, data, 0o644); err != nil {
+		utils.Logger.Error("resume.write_failed", "path", t.path, "error", err.Error())
+	}
+}
+
+// finish 矩阵全部成功后清掉断点文件
+func (t *resumeTracker) finish(report *Report) {
+	if report.Failed() == 0 {
+		os.Remove(t.path)
+	}
+}
@@ -0,0 +1,96 @@
+package gobuild
+
+import (
+	"strings"
+	"time"
+)
+
+// RetryPolicy 构建失败的重试策略
+type RetryPolicy struct {
+	// MaxAttempts 最大尝试次数（含首次），<=0 时视为 1
+	MaxAttempts int
+	// Backoff 首次重试前的等待时间，之后指数增长
+	Backoff time.Duration
+	// MaxBackoff 退避时间上限
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy 默认不重试
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 1, Backoff: 2 * time.Second, MaxBackoff: 30 * time.Second}
+
+func (p RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// delay 返回第 attempt 次失败后的退避时间（指数递增，封顶 MaxBackoff）
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := p.Backoff
+	if d <= 0 {
+		d = DefaultRetryPolicy.Backoff
+	}
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	return d
+}
+
+// errClass 构建错误分类
+type errClass int
+
+const (
+	// errTransient 临时性错误（文件锁、链接器被杀等），值得重试
+	errTransient errClass = iota
+	// errPermanent 确定性错误（编译器缺失、代码编译错误等），重试无意义
+	errPermanent
+)
+
+// permanentPatterns 确定性失败的特征串，出现即不再重试
+var permanentPatterns = []string{
+	"C compiler",
+	"compiler not found",
+	"executable file not found",
+	"unsupported GOOS/GOARCH",
+	"cgo: exec",
+	"syntax error",
+	"undefined:",
+	"cannot find package",
+	"no Go files",
+	"build constraints exclude all Go files",
+	"invalid flag",
+	"unknown flag",
+}
+
+// transientPatterns 已知的临时性失败特征串
+var transientPatterns = []string{
+	"text file busy",
+	"resource temporarily unavailable",
+	"signal: killed",
+	"cannot allocate memory",
+	"input/output error",
+	"file already exists",
+	"connection reset",
+	"timeout",
+}
+
+// classifyBuildError 根据 go build 输出判断错误是否值得重试。
+// 未命中任何特征时按临时错误处理，交给次数上限兜底。
+func classifyBuildError(output string) errClass {
+	lower := strings.ToLower(output)
+	for _, pat := range permanentPatterns {
+		if strings.Contains(lower, strings.ToLower(pat)) {
+			return errPermanent
+		}
+	}
+	for _, pat := range transientPatterns {
+		if strings.Contains(lower, strings.ToLower(pat)) {
+			return errTransient
+		}
+	}
+	return errTransient
+}
@@ -0,0 +1,37 @@
+package gobuild
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClassifyBuildError(t *testing.T) {
+	cases := []struct {
+		output string
+		want   errClass
+	}{
+		{"cgo: C compiler \"aarch64-linux-android21-clang\" not found", errPermanent},
+		{"# pkg\n./main.go:10:2: undefined: foo", errPermanent},
+		{"link: text file busy", errTransient},
+		{"signal: killed", errTransient},
+		{"something unrecognizable", errTransient},
+	}
+	for _, c := range cases {
+		if got := classifyBuildError(c.output); got != c.want {
+			t.Errorf("classifyBuildError(%q) = %v, want %v", c.output, got, c.want)
+		}
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	if got := p.delay(1); got != time.Second {
+		t.Errorf("delay(1) = %v", got)
+	}
+	if got := p.delay(2); got != 2*time.Second {
+		t.Errorf("delay(2) = %v", got)
+	}
+	if got := p.delay(4); got != 5*time.Second {
+		t.Errorf("delay(4) 应封顶 MaxBackoff, got %v", got)
+	}
+}
@@ -0,0 +1,192 @@
+package gobuild
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+// lowMemoryThresholdMB 可用内存低于该值时收缩并发
+const lowMemoryThresholdMB = 512
+
+// highLoadFactor 1 分钟负载超过核数的该倍数时视为过载
+const highLoadFactor = 1.5
+
+// scheduler 控制构建矩阵的执行顺序与并发度：
+// 宿主平台优先、支持 fail-fast 取消、根据内存/负载压力在
+// [floor, ceiling] 区间内动态伸缩并发
+type scheduler struct {
+	cfg     *Config
+	ctx     context.Context
+	cancel  context.CancelFunc
+	floor   int // 并发下限
+	ceiling int // 并发上限
+
+	mu      sync.Mutex
+	workers int // 当前允许的并发数
+	active  int // 正在运行的任务数
+	cond    *sync.Cond
+}
+
+func newScheduler(cfg *Config) *scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &scheduler{cfg: cfg, ctx: ctx, cancel: cancel}
+	s.floor = cfg.MinParallel
+	if s.floor < 1 {
+		s.floor = 1
+	}
+	s.ceiling = cfg.MaxParallel
+	if s.ceiling <= 0 {
+		s.ceiling = cfg.Parallel
+	}
+	if s.ceiling < s.floor {
+		s.ceiling = s.floor
+	}
+	s.workers = cfg.Parallel
+	if s.workers < s.floor {
+		s.workers = s.floor
+	}
+	if s.workers > s.ceiling {
+		s.workers = s.ceiling
+	}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+// DefaultParallel 推导默认并发数：核数为上限，
+// 再按可用内存约每 GB 一个编译器进程收紧，照顾小内存设备
+func DefaultParallel() int {
+	n := runtime.NumCPU()
+	if avail := memAvailableMB(); avail > 0 {
+		if byMem := avail / 1024; byMem < n {
+			n = byMem
+		}
+	}
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// orderTargets 返回按优先级排序的目标：宿主平台最先，其余保持原顺序
+func orderTargets(platforms []platform.Platform) []platform.Platform {
+	host := platform.Host(runtime.GOOS, runtime.GOARCH)
+	ordered := make([]platform.Platform, len(platforms))
+	copy(ordered, platforms)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i] == host && ordered[j] != host
+	})
+	return ordered
+}
+
+// acquire 阻塞直到获得一个执行名额；调度器已取消时返回 false
+func (s *scheduler) acquire() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for s.active >= s.workers {
+		if s.ctx.Err() != nil {
+			return false
+		}
+		s.cond.Wait()
+	}
+	if s.ctx.Err() != nil {
+		return false
+	}
+	s.active++
+	return true
+}
+
+func (s *scheduler) release() {
+	s.mu.Lock()
+	s.active--
+	s.mu.Unlock()
+	s.cond.Broadcast()
+}
+
+// abort 触发 fail-fast：取消所有尚未开始的任务
+func (s *scheduler) abort() {
+	s.cancel()
+	s.cond.Broadcast()
+}
+
+// monitorMemory 定期检查可用内存与 1 分钟负载，
+// 压力大时收缩并发（下限 floor），缓解后恢复（上限 ceiling）
+func (s *scheduler) monitorMemory() {
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		avail := memAvailableMB()
+		if avail <= 0 {
+			continue // 无法探测（非 linux），维持现状
+		}
+		overloaded := false
+		if load := loadAvg1(); load > 0 {
+			overloaded = load > float64(runtime.NumCPU())*highLoadFactor
+		}
+		s.mu.Lock()
+		switch {
+		case (avail < lowMemoryThresholdMB || overloaded) && s.workers > s.floor:
+			s.workers--
+		case avail > lowMemoryThresholdMB*2 && !overloaded && s.workers < s.ceiling:
+			s.workers++
+		}
+		s.mu.Unlock()
+		s.cond.Broadcast()
+	}
+}
+
+// loadAvg1 读取 /proc/loadavg 的 1 分钟负载，失败返回 -1
+func loadAvg1() float64 {
+	data, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return -1
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 1 {
+		return -1
+	}
+	load, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return -1
+	}
+	return load
+}
+
+// memAvailableMB 读取 /proc/meminfo 的 MemAvailable，失败返回 -1
+func memAvailableMB() int {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return -1
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "MemAvailable:") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return -1
+		}
+		kb, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return -1
+		}
+		return kb / 1024
+	}
+	return -1
+}
@@ -0,0 +1,105 @@
+package gobuild
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// writeChecksumFile 为所有成功产物生成 SHA256SUMS，返回文件路径
+func writeChecksumFile(cfg *Config, report *Report) (string, error) {
+	var lines []string
+	for _, res := range report.Results {
+		if res.Err != nil || res.Skipped {
+			continue
+		}
+		f, err := os.Open(res.OutputPath)
+		if err != nil {
+			return "", err
+		}
+		h := sha256.New()
+		if _, err := io.Copy(h, f); err != nil {
+			f.Close()
+			return "", err
+		}
+		f.Close()
+		rel, err := filepath.Rel(cfg.OutputDir, res.OutputPath)
+		if err != nil {
+			rel = res.OutputPath
+		}
+		lines = append(lines, fmt.Sprintf("%x  %s", h.Sum(nil), filepath.ToSlash(rel)))
+	}
+	path := filepath.Join(cfg.OutputDir, "SHA256SUMS")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// signArtifacts 按 --sign 配置为每个产物和校验文件生成分离签名。
+// 支持 "gpg:<keyid>" 与 "cosign" 两种方式。
+func signArtifacts(cfg *Config, report *Report) error {
+	checksums, err := writeChecksumFile(cfg, report)
+	if err != nil {
+		return fmt.Errorf("生成校验文件失败: %w", err)
+	}
+
+	var files []string
+	for _, res := range report.Results {
+		if res.Err == nil && !res.Skipped {
+			files = append(files, res.OutputPath)
+		}
+	}
+	files = append(files, checksums)
+
+	switch {
+	case strings.HasPrefix(cfg.Sign, "gpg:"):
+		keyID := strings.TrimPrefix(cfg.Sign, "gpg:")
+		return signWithGPG(keyID, files)
+	case cfg.Sign == "cosign":
+		return signWithCosign(files)
+	default:
+		return fmt.Errorf("未知的签名方式: %q (支持 gpg:<keyid> / cosign)", cfg.Sign)
+	}
+}
+
+func signWithGPG(keyID string, files []string) error {
+	if _, err := exec.LookPath("gpg"); err != nil {
+		return fmt.Errorf("未找到 gpg 命令")
+	}
+	for _, file := range files {
+		args := []string{"--batch", "--yes", "--armor", "--detach-sign", "-o", file + ".asc"}
+		if keyID != "" {
+			args = append(args, "-u", keyID)
+		}
+		args = append(args, file)
+		if out, err := exec.Command("gpg", args...).CombinedOutput(); err != nil {
+			return fmt.Errorf("gpg 签名失败 (%s): %w\n%s", file, err, out)
+		}
+		utils.Logger.Info("sign.gpg", "file", file, "key", keyID)
+	}
+	fmt.Printf("🔏 已用 GPG 签名 %d 个文件\n", len(files))
+	return nil
+}
+
+func signWithCosign(files []string) error {
+	if _, err := exec.LookPath("cosign"); err != nil {
+		return fmt.Errorf("未找到 cosign 命令")
+	}
+	for _, file := range files {
+		cmd := exec.Command("cosign", "sign-blob", "--yes",
+			"--output-signature", file+".sig", file)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("cosign 签名失败 (%s): %w\n%s", file, err, out)
+		}
+		utils.Logger.Info("sign.cosign", "file", file)
+	}
+	fmt.Printf("🔏 已用 cosign 签名 %d 个文件\n", len(files))
+	return nil
+}
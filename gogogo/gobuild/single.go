@@ -0,0 +1,354 @@
+package gobuild
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/events"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/i18n"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// defaultAndroidAPI 未指定 --android-api 时的 API 等级
+const defaultAndroidAPI = 21
+
+// Result 单个平台的构建结果
+type Result struct {
+	Platform   platform.Platform
+	OutputPath string
+	Duration   time.Duration
+	Attempts   int
+	Skipped    bool
+	SkipReason string
+	// AndroidAPI android + CGO 构建实际使用的 API 等级，其余目标为 0
+	AndroidAPI int
+	// Env 本次构建相对宿主环境的全部覆盖项 (GOOS/CC/CGO_ENABLED 等)
+	Env []string
+	// LogPath 该目标完整 go build 输出的日志文件
+	LogPath string
+	// Static --static 模式下的链接校验结果: static/dynamic/unknown，未校验为空
+	Static string
+	// SmokeExit / SmokeOutput --smoke 冒烟测试的退出码与输出
+	SmokeExit   int
+	SmokeOutput string
+	// XFail 该目标在配置中标注为预期失败，失败不影响退出码
+	XFail bool
+	// XFailReason 预期失败的原因 (来自配置)
+	XFailReason string
+	Err         error
+}
+
+// needsCGO 判断目标是否需要 CGO：
+// 仅当目标系统惯常依赖 CGO (android/ios) 且源码确实引用了
+// cgo 包时才需要，由 sourceNeedsCGO 探测
+func needsCGO(cfg *Config, goos string) bool {
+	switch goos {
+	case "android", "ios":
+		return sourceNeedsCGO(cfg.Source, cfg.Dir)
+	}
+	return false
+}
+
+// buildTmpDir 目标独占的 GOTMPDIR 路径。
+// 每个目标单独一份，避免高并发下 tmpfs 上的
+// 链接器临时文件互相踩踏 ("text file busy")
+func buildTmpDir(cfg *Config, p platform.Platform) string {
+	return filepath.Join(cfg.OutputDir, ".gogogo-tmp", p.OS+"_"+p.ArchLabel()+"-"+cfg.Name)
+}
+
+// assembleBaseEnv 组装目标的基础环境覆盖 (GOOS/GOARCH/GOTMPDIR/
+// GOCACHE/离线与目标 env 文件/CGO_ENABLED) 并给出 CGO 判定。
+// BuildSingle 与 dry-run 计划共用这一份逻辑，保证计划展示的
+// 环境就是真实构建用的环境；NDK/交叉 CC 等交互式补充由调用方叠加
+func assembleBaseEnv(cfg *Config, p platform.Platform) (*envSet, bool, error) {
+	env := newEnvSet()
+	env.Set("GOOS", p.OS)
+	env.Set("GOARCH", p.Arch)
+	env.Set("GOTMPDIR", buildTmpDir(cfg, p))
+	if key, value := p.VariantEnv(); key != "" {
+		env.Set(key, value)
+	}
+	env.Apply(toolchainEnv(cfg))
+	if cfg.GoCache != "" {
+		env.Set("GOCACHE", cfg.GoCache)
+	} else if cache := resolveGoCache(); cache != "" {
+		env.Set("GOCACHE", cache)
+	}
+	if cfg.Offline {
+		env.Apply(offlineEnv(cfg.Source))
+	}
+	targetEnv, err := loadTargetEnv(cfg, p.OS)
+	if err != nil {
+		return nil, false, fmt.Errorf("读取目标环境文件失败: %w", err)
+	}
+	env.Apply(targetEnv)
+	cgo := cfg.CGO || needsCGO(cfg, p.OS) || buildModeNeedsCGO(cfg.BuildMode)
+	if cgo {
+		env.Set("CGO_ENABLED", "1")
+	} else {
+		env.Set("CGO_ENABLED", "0")
+	}
+	return env, cgo, nil
+}
+
+// assembleBuildArgs 组装 go build 的参数列表 (不含前导 "go")，
+// 含 --static 的 ldflags/tags 改写与 -buildmode/-cover。
+// 同样由 BuildSingle 与 dry-run 计划共用，防止两边各自漂移
+func assembleBuildArgs(cfg *Config, p platform.Platform, cgo bool, outPath string) ([]string, error) {
+	ldflags, tags := cfg.Ldflags, cfg.Tags
+	if cfg.Static && p.OS == "linux" {
+		if cgo {
+			ldflags = strings.TrimSpace(ldflags + " " + staticLdflags)
+		} else {
+			tags = appendTags(tags, staticTags)
+		}
+	}
+	args := []string{"build", "-o", outPath}
+	if cfg.BuildMode != "" {
+		args = append(args, "-buildmode", cfg.BuildMode)
+	}
+	if cfg.Cover {
+		args = append(args, "-cover")
+	}
+	if ldflags != "" {
+		args = append(args, "-ldflags", ldflags)
+	}
+	if tags != "" {
+		args = append(args, "-tags", tags)
+	}
+	if cfg.GoFlags != "" {
+		extra, err := SplitFlags(cfg.GoFlags)
+		if err != nil {
+			return nil, fmt.Errorf("--go-flags 解析失败: %w", err)
+		}
+		args = append(args, extra...)
+	}
+	args = append(args, cfg.Source)
+	return args, nil
+}
+
+// BuildSingle 为单个平台执行 go build
+func BuildSingle(cfg *Config, p platform.Platform) *Result {
+	start := time.Now()
+	res := &Result{Platform: p}
+	if reason, ok := cfg.SkipTargets[p.String()]; ok {
+		res.Skipped = true
+		res.SkipReason = "配置跳过: " + reason
+		return res
+	}
+	if reason, ok := cfg.XFailTargets[p.String()]; ok {
+		res.XFail = true
+		res.XFailReason = reason
+	}
+
+	ext := p.Ext()
+	if cfg.BuildMode != "" {
+		if err := validateBuildMode(cfg.BuildMode, p); err != nil {
+			res.Skipped = true
+			res.SkipReason = err.Error()
+			return res
+		}
+		ext = buildModeExt(cfg.BuildMode, p)
+	}
+	outDir := ArtifactDir(cfg, p)
+	outPath := ArtifactPath(cfg, p, ext)
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		res.Err = err
+		return res
+	}
+	res.OutputPath = outPath
+
+	if err := runPreHooks(cfg, p, outPath); err != nil {
+		res.Err = err
+		return res
+	}
+
+	env, cgo, err := assembleBaseEnv(cfg, p)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	tmpDir := buildTmpDir(cfg, p)
+	if err := os.MkdirAll(tmpDir, 0o755); err != nil {
+		res.Err = err
+		return res
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if p.OS == "android" && cgo {
+		ndk := utils.FindNDK()
+		if ndk == "" && !cfg.NonInteractive {
+			ndk = utils.Input("ndk.path", "请输入 Android NDK 路径 (留空跳过)", "")
+		}
+		if ndk == "" {
+			if cfg.NonInteractive || utils.Confirm("ndk.continue_without_cgo", i18n.T("ndk.not_found_confirm")) {
+				env.Set("CGO_ENABLED", "0")
+			} else {
+				res.Skipped = true
+				res.SkipReason = i18n.T("ndk.missing")
+				return res
+			}
+		} else {
+			api := cfg.AndroidAPI
+			if api <= 0 {
+				api = defaultAndroidAPI
+			}
+			ndkEnv, err := utils.SetupNDKEnvironment(nil, ndk, p.Arch, api)
+			if err != nil {
+				res.Err = err
+				return res
+			}
+			env.Apply(ndkEnv)
+			res.AndroidAPI = api
+		}
+	}
+
+	if p.OS == "linux" && cgo {
+		// 用户经 CC 环境变量或 env 文件指定过编译器时不覆盖
+		if _, ok := env.values["CC"]; !ok && os.Getenv("CC") == "" {
+			cc := ""
+			if cfg.Static {
+				// 静态链接优先 musl，glibc 静态链接的 NSS 告警不断
+				cc = utils.FindMuslGCC(p.Arch)
+			}
+			if cc == "" {
+				cc = utils.FindCrossGCC(p.Arch)
+			}
+			if cc == "" {
+				res.Err = fmt.Errorf("未找到 linux/%s 的 CGO 交叉编译器，请安装对应的 gcc 工具链或设置 CC", p.Arch)
+				return res
+			}
+			env.Set("CC", cc)
+			env.Set("CXX", staticCXXFor(cc))
+		}
+	}
+
+	if p.OS == "ios" && cgo {
+		iosEnv, err := utils.SetupIOSEnvironment(nil, p.Arch)
+		if err != nil {
+			res.Err = err
+			return res
+		}
+		env.Apply(iosEnv)
+	}
+
+	res.Env = env.Overrides()
+	if cfg.Verbosity >= 2 {
+		utils.PrintEnvironmentVars(res.Env)
+	}
+
+	args, err := assembleBuildArgs(cfg, p, cgo, outPath)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	utils.Logger.Info("build.start",
+		"platform", p.String(), "args", args, "output", outPath, "cgo", cgo)
+
+	var buildLog bytes.Buffer
+	policy := cfg.Retry
+	for attempt := 1; ; attempt++ {
+		res.Attempts = attempt
+		cmd := buildCommand(cfg, args)
+		cmd.Dir = cfg.Dir
+		cmd.Env = env.Environ()
+		out, err := cmd.CombinedOutput()
+		fmt.Fprintf(&buildLog, "=== attempt %d: go %v\n", attempt, args)
+		buildLog.Write(out)
+		if err == nil {
+			res.Err = nil
+			break
+		}
+		utils.Logger.Error("build.attempt_failed",
+			"platform", p.String(), "attempt", attempt, "error", err.Error(), "output", string(out))
+		res.Err = fmt.Errorf(i18n.T("build.go_build_failed"), err, out)
+		if classifyBuildError(string(out)) == errPermanent {
+			if cfg.Verbosity >= 1 {
+				fmt.Print(i18n.T("retry.permanent", p))
+			}
+			break
+		}
+		if attempt >= policy.maxAttempts() {
+			break
+		}
+		delay := policy.delay(attempt)
+		if cfg.Verbosity >= 1 {
+			fmt.Print(i18n.T("retry.retrying", p, attempt, delay))
+		}
+		if cfg.Events != nil {
+			cfg.Events.Publish(events.Event{
+				Type: events.TypeRetrying, Target: p.String(), Attempt: attempt})
+		}
+		time.Sleep(delay)
+	}
+
+	res.LogPath = writeTargetLog(cfg, p, buildLog.Bytes())
+	if res.Err != nil && res.LogPath != "" {
+		res.Err = fmt.Errorf("%w\n%s", res.Err, i18n.T("build.log_hint", res.LogPath))
+	}
+
+	// c-archive 是 ar 归档不是可执行格式，跳过格式校验
+	if res.Err == nil && cfg.BuildMode != "c-archive" {
+		if err := verifyArtifact(p, outPath); err != nil {
+			res.Err = fmt.Errorf("产物校验失败: %w", err)
+		}
+	}
+
+	if res.Err == nil && cfg.Static && p.OS == "linux" {
+		static, err := isStaticELF(outPath)
+		switch {
+		case err != nil:
+			res.Static = "unknown"
+		case static:
+			res.Static = "static"
+		default:
+			res.Static = "dynamic"
+			fmt.Printf("⚠️  %s 仍是动态链接的二进制\n", p)
+		}
+	}
+
+	if res.Err == nil && cfg.WasmHarness && p.Arch == "wasm" {
+		if err := writeWasmHarness(cfg, p, outPath); err != nil {
+			res.Err = fmt.Errorf("生成 wasm 载体失败: %w", err)
+		}
+	}
+
+	if res.Err == nil && cfg.Smoke != "" && !buildModeNeedsCGO(cfg.BuildMode) {
+		if err := runSmoke(cfg, p, outPath, res); err != nil {
+			res.Err = err
+		}
+	}
+
+	// CGO 目标失败时同样留下环境快照：android/ios 的故障报告
+	// 最缺的就是最终生效的 CC/CXX 与编译参数
+	if res.Err == nil || len(CGOEnvEcho(res.Env)) > 0 {
+		writeEnvSnapshot(cfg, p, res)
+	}
+
+	if res.Err == nil && cfg.SBOM != "" {
+		if err := writeSBOM(cfg, res); err != nil {
+			res.Err = fmt.Errorf("SBOM 生成失败: %w", err)
+		}
+	}
+
+	if res.Err == nil {
+		if err := runPlugins(cfg, res); err != nil {
+			res.Err = err
+		}
+	}
+
+	res.Duration = time.Since(start)
+	if err := runPostHooks(cfg, res); err != nil && res.Err == nil {
+		res.Err = err
+	}
+	utils.Logger.Info("build.done",
+		"platform", p.String(), "duration", res.Duration.String(),
+		"attempts", res.Attempts, "success", res.Err == nil)
+	return res
+}
@@ -0,0 +1,77 @@
+package gobuild
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// EmulatorFor 返回可在宿主上执行该平台二进制的模拟器命令，
+// 未安装或平台无模拟器时返回空串
+func EmulatorFor(p platform.Platform) string {
+	var candidate string
+	switch {
+	case p.OS == "wasip1" && p.Arch == "wasm":
+		candidate = "wasmtime"
+	case p.OS == "linux" && runtime.GOOS == "linux":
+		switch p.Arch {
+		case "arm64":
+			candidate = "qemu-aarch64"
+		case "arm":
+			candidate = "qemu-arm"
+		case "riscv64":
+			candidate = "qemu-riscv64"
+		case "s390x":
+			candidate = "qemu-s390x"
+		case "ppc64le":
+			candidate = "qemu-ppc64le"
+		case "mips64le":
+			candidate = "qemu-mips64el"
+		}
+	}
+	if candidate == "" {
+		return ""
+	}
+	if _, err := exec.LookPath(candidate); err != nil {
+		return ""
+	}
+	return candidate
+}
+
+// smokeRunner 返回执行产物的命令前缀：
+// 宿主同平台直接执行，其余尝试模拟器；不可执行时返回 nil
+func smokeRunner(p platform.Platform, outPath string) []string {
+	if p.OS == runtime.GOOS && p.Arch == runtime.GOARCH {
+		return []string{outPath}
+	}
+	if emu := EmulatorFor(p); emu != "" {
+		return []string{emu, outPath}
+	}
+	return nil
+}
+
+// runSmoke 用 --smoke 的参数执行产物做冒烟测试，
+// 退出码与输出记入结果；宿主无法执行的目标跳过
+func runSmoke(cfg *Config, p platform.Platform, outPath string, res *Result) error {
+	runner := smokeRunner(p, outPath)
+	if runner == nil {
+		return nil
+	}
+	args, err := SplitFlags(cfg.Smoke)
+	if err != nil {
+		return fmt.Errorf("--smoke 解析失败: %w", err)
+	}
+	cmd := exec.Command(runner[0], append(runner[1:], args...)...)
+	out, err := cmd.CombinedOutput()
+	res.SmokeOutput = string(out)
+	res.SmokeExit = cmd.ProcessState.ExitCode()
+	utils.Logger.Info("smoke.run",
+		"platform", p.String(), "runner", runner[0], "exit", res.SmokeExit)
+	if err != nil {
+		return fmt.Errorf("冒烟测试失败 (退出码 %d):\n%s", res.SmokeExit, out)
+	}
+	return nil
+}
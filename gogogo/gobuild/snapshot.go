@@ -0,0 +1,143 @@
+package gobuild
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// EnvSnapshot 单个产物的工具链环境快照，
+// 写在产物旁的 <名字>.buildenv.json，供 gogogo inspect 追溯
+type EnvSnapshot struct {
+	// Gogogo 构建所用的 gogogo 版本
+	Gogogo string `json:"gogogo"`
+	// GoVersion 宿主 go version 输出
+	GoVersion string `json:"go_version"`
+	// Target 目标平台 (os/arch[/variant])
+	Target string `json:"target"`
+	// Overrides 相对宿主环境的全部覆盖项 (GOOS/CC/CGO_ENABLED 等)
+	Overrides []string `json:"overrides,omitempty"`
+	// CGOEnv CGO 目标最终解析出的 CC/CXX/CGO_CFLAGS/CGO_LDFLAGS，
+	// 含从宿主环境继承的值；android/ios 故障报告靠它定位工具链问题
+	CGOEnv map[string]string `json:"cgo_env,omitempty"`
+	// CCVersion CGO 编译器 --version 的首行，纯 Go 构建为空
+	CCVersion string `json:"cc_version,omitempty"`
+	// NDKVersion android CGO 构建使用的 NDK 修订号
+	NDKVersion string `json:"ndk_version,omitempty"`
+	// AndroidAPI android CGO 构建的 API 等级
+	AndroidAPI int `json:"android_api,omitempty"`
+	// BuiltAt 构建时间
+	BuiltAt time.Time `json:"built_at"`
+}
+
+// SnapshotPath 返回产物对应的环境快照路径
+func SnapshotPath(artifact string) string {
+	return artifact + ".buildenv.json"
+}
+
+// writeEnvSnapshot 在产物旁写入工具链环境快照，失败只记日志不影响构建
+func writeEnvSnapshot(cfg *Config, p platform.Platform, res *Result) {
+	snap := EnvSnapshot{
+		Gogogo:     cfg.ToolVersion,
+		GoVersion:  hostGoVersion(),
+		Target:     p.String(),
+		Overrides:  res.Env,
+		AndroidAPI: res.AndroidAPI,
+		BuiltAt:    time.Now(),
+	}
+	snap.CGOEnv = CGOEnvEcho(res.Env)
+	if cc := overrideValue(res.Env, "CC"); cc != "" {
+		snap.CCVersion = compilerVersion(cc)
+	}
+	if res.AndroidAPI > 0 {
+		snap.NDKVersion = ndkRevision(utils.FindNDK())
+	}
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		utils.Logger.Error("snapshot.marshal", "error", err.Error())
+		return
+	}
+	path := SnapshotPath(res.OutputPath)
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		utils.Logger.Error("snapshot.write", "path", path, "error", err.Error())
+	}
+}
+
+// cgoEchoKeys 需要回显到报告里的 CGO 工具链变量
+var cgoEchoKeys = []string{"CC", "CXX", "CGO_CFLAGS", "CGO_LDFLAGS"}
+
+// CGOEnvEcho 汇总 CGO 目标最终生效的工具链变量：
+// 覆盖项优先，未覆盖的取宿主环境值；非 CGO 构建返回 nil
+func CGOEnvEcho(env []string) map[string]string {
+	if overrideValue(env, "CGO_ENABLED") != "1" {
+		return nil
+	}
+	echo := map[string]string{}
+	for _, key := range cgoEchoKeys {
+		value := overrideValue(env, key)
+		if value == "" {
+			value = os.Getenv(key)
+		}
+		if value != "" {
+			echo[key] = value
+		}
+	}
+	return echo
+}
+
+// overrideValue 从 KEY=VALUE 列表中取指定键的值
+func overrideValue(env []string, key string) string {
+	for _, kv := range env {
+		if v, ok := strings.CutPrefix(kv, key+"="); ok {
+			return v
+		}
+	}
+	return ""
+}
+
+// hostGoVersion 宿主 go version 输出
+func hostGoVersion() string {
+	out, err := exec.Command("go", "version").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// compilerVersion 编译器 --version 输出的首行
+func compilerVersion(cc string) string {
+	// CC 可能带 flags (如 "clang -isysroot ...")，只取命令本体
+	fields := strings.Fields(cc)
+	if len(fields) == 0 {
+		return ""
+	}
+	out, err := exec.Command(fields[0], "--version").Output()
+	if err != nil {
+		return ""
+	}
+	line, _, _ := strings.Cut(string(out), "\n")
+	return strings.TrimSpace(line)
+}
+
+// ndkRevision 读取 NDK 根目录 source.properties 的 Pkg.Revision
+func ndkRevision(ndk string) string {
+	if ndk == "" {
+		return ""
+	}
+	data, err := os.ReadFile(ndk + "/source.properties")
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if key, value, ok := strings.Cut(line, "="); ok &&
+			strings.TrimSpace(key) == "Pkg.Revision" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
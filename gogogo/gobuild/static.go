@@ -0,0 +1,42 @@
+package gobuild
+
+import (
+	"debug/elf"
+	"strings"
+)
+
+// staticTags --static 在纯 Go 模式下追加的标签，
+// 避免 net/user 包走 cgo 路径引入动态链接
+const staticTags = "netgo,osusergo"
+
+// staticLdflags --static 在 CGO 模式下传给外部链接器的参数
+const staticLdflags = "-extldflags=-static"
+
+// appendTags 把额外标签并入已有的 -tags 值
+func appendTags(tags, extra string) string {
+	if tags == "" {
+		return extra
+	}
+	return tags + "," + extra
+}
+
+// isStaticELF 检查 ELF 是否为真正的静态二进制：
+// 存在 PT_INTERP 或 PT_DYNAMIC 段即为动态链接
+func isStaticELF(path string) (bool, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	for _, prog := range f.Progs {
+		if prog.Type == elf.PT_INTERP || prog.Type == elf.PT_DYNAMIC {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// staticCXXFor 从 musl gcc 路径推导对应的 g++
+func staticCXXFor(cc string) string {
+	return strings.TrimSuffix(cc, "gcc") + "g++"
+}
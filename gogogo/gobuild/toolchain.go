@@ -0,0 +1,39 @@
+package gobuild
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// normalizeToolchain 把 "1.22.4" / "go1.22.4" 统一为 GOTOOLCHAIN 取值
+func normalizeToolchain(version string) string {
+	if version == "" {
+		return ""
+	}
+	if !strings.HasPrefix(version, "go") {
+		return "go" + version
+	}
+	return version
+}
+
+// toolchainEnv 返回按 --go-version 切换工具链的环境变量；
+// go 1.21+ 会按 GOTOOLCHAIN 自动下载并缓存对应版本
+func toolchainEnv(cfg *Config) []string {
+	tc := normalizeToolchain(cfg.GoVersion)
+	if tc == "" {
+		return nil
+	}
+	return []string{"GOTOOLCHAIN=" + tc}
+}
+
+// resolveGoVersion 报告本次构建实际使用的 go 版本
+func resolveGoVersion(cfg *Config) string {
+	cmd := exec.Command("go", "version")
+	cmd.Env = append(os.Environ(), toolchainEnv(cfg)...)
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
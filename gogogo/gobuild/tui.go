@@ -0,0 +1,95 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// tui 全屏实时仪表盘，避免并行构建下进度条与 Printf 交错。
+// 用 ANSI 清屏+重绘实现，不引入终端 UI 依赖；Ctrl+C 仍可随时取消。
+type tui struct {
+	mu   sync.Mutex
+	rows []tuiRow
+	stop chan struct{}
+	done chan struct{}
+}
+
+type tuiRow struct {
+	label    string
+	status   string
+	started  time.Time
+	duration time.Duration
+}
+
+const (
+	tuiQueued   = "⏳ 等待"
+	tuiBuilding = "🔨 构建中"
+	tuiOK       = "✅ 完成"
+	tuiFailed   = "❌ 失败"
+	tuiSkipped  = "⏭️ 跳过"
+)
+
+func newTUI(labels []string) *tui {
+	t := &tui{stop: make(chan struct{}), done: make(chan struct{})}
+	for _, label := range labels {
+		t.rows = append(t.rows, tuiRow{label: label, status: tuiQueued})
+	}
+	return t
+}
+
+// setStatus 更新一行的状态并记录起止时间
+func (t *tui) setStatus(i int, status string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	row := &t.rows[i]
+	row.status = status
+	switch status {
+	case tuiBuilding:
+		row.started = time.Now()
+	case tuiOK, tuiFailed, tuiSkipped:
+		if !row.started.IsZero() {
+			row.duration = time.Since(row.started)
+		}
+	}
+}
+
+// loop 以 5Hz 重绘整个表格，直到 stopLoop 被调用
+func (t *tui) loop() {
+	defer close(t.done)
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		t.render()
+		select {
+		case <-t.stop:
+			t.render()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *tui) stopLoop() {
+	close(t.stop)
+	<-t.done
+}
+
+func (t *tui) render() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// 光标回原点并清屏
+	fmt.Fprint(os.Stdout, "\x1b[H\x1b[2J")
+	fmt.Fprintf(os.Stdout, "🚀 gogogo 构建矩阵 (%d 目标)\n\n", len(t.rows))
+	for _, row := range t.rows {
+		switch row.status {
+		case tuiBuilding:
+			fmt.Fprintf(os.Stdout, "  %-32s %s %.1fs\n", row.label, row.status, time.Since(row.started).Seconds())
+		case tuiOK, tuiFailed:
+			fmt.Fprintf(os.Stdout, "  %-32s %s %.1fs\n", row.label, row.status, row.duration.Seconds())
+		default:
+			fmt.Fprintf(os.Stdout, "  %-32s %s\n", row.label, row.status)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package gobuild
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"fmt"
+	"os"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+// elfMachines GOARCH 对应的 ELF 机器类型
+var elfMachines = map[string]elf.Machine{
+	"amd64":    elf.EM_X86_64,
+	"386":      elf.EM_386,
+	"arm64":    elf.EM_AARCH64,
+	"arm":      elf.EM_ARM,
+	"riscv64":  elf.EM_RISCV,
+	"ppc64":    elf.EM_PPC64,
+	"ppc64le":  elf.EM_PPC64,
+	"s390x":    elf.EM_S390,
+	"mips":     elf.EM_MIPS,
+	"mipsle":   elf.EM_MIPS,
+	"mips64":   elf.EM_MIPS,
+	"mips64le": elf.EM_MIPS,
+	"loong64":  elf.EM_LOONGARCH,
+}
+
+// peMachines GOARCH 对应的 PE 机器类型
+var peMachines = map[string]uint16{
+	"amd64": pe.IMAGE_FILE_MACHINE_AMD64,
+	"386":   pe.IMAGE_FILE_MACHINE_I386,
+	"arm64": pe.IMAGE_FILE_MACHINE_ARM64,
+	"arm":   pe.IMAGE_FILE_MACHINE_ARMNT,
+}
+
+// machoCPUs GOARCH 对应的 Mach-O CPU 类型
+var machoCPUs = map[string]macho.Cpu{
+	"amd64": macho.CpuAmd64,
+	"arm64": macho.CpuArm64,
+}
+
+// wasmMagic WebAssembly 模块的魔数
+var wasmMagic = []byte{0x00, 0x61, 0x73, 0x6d}
+
+// verifyArtifact 校验产物确实是目标平台的二进制：
+// 文件格式魔数与机器类型都要和 GOOS/GOARCH 对上。
+// 环境覆盖丢失时 go build 会默默产出宿主架构的二进制，这里兜底。
+func verifyArtifact(p platform.Platform, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("产物缺失: %w", err)
+	}
+	if info.Size() == 0 {
+		return fmt.Errorf("产物为空文件: %s", path)
+	}
+
+	switch p.OS {
+	case "linux", "android", "freebsd", "openbsd", "netbsd", "dragonfly", "solaris", "illumos":
+		f, err := elf.Open(path)
+		if err != nil {
+			return fmt.Errorf("产物不是 ELF: %w", err)
+		}
+		defer f.Close()
+		if want, ok := elfMachines[p.Arch]; ok && f.Machine != want {
+			return fmt.Errorf("ELF 机器类型 %v 与目标 %s 不符", f.Machine, p.Arch)
+		}
+	case "windows":
+		f, err := pe.Open(path)
+		if err != nil {
+			return fmt.Errorf("产物不是 PE: %w", err)
+		}
+		defer f.Close()
+		if want, ok := peMachines[p.Arch]; ok && f.Machine != want {
+			return fmt.Errorf("PE 机器类型 %#x 与目标 %s 不符", f.Machine, p.Arch)
+		}
+	case "darwin", "ios":
+		f, err := macho.Open(path)
+		if err != nil {
+			return fmt.Errorf("产物不是 Mach-O: %w", err)
+		}
+		defer f.Close()
+		if want, ok := machoCPUs[p.Arch]; ok && f.Cpu != want {
+			return fmt.Errorf("Mach-O CPU %v 与目标 %s 不符", f.Cpu, p.Arch)
+		}
+	case "js", "wasip1":
+		head := make([]byte, 4)
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if _, err := f.Read(head); err != nil || !bytes.Equal(head, wasmMagic) {
+			return fmt.Errorf("产物不是 WebAssembly 模块: %s", path)
+		}
+	default:
+		// plan9/aix 等格式没有标准库解析器，只做大小检查
+	}
+	return nil
+}
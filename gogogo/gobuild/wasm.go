@@ -0,0 +1,92 @@
+package gobuild
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+// wasmIndexHTML js/wasm 的浏览器加载页模板，%s 为 wasm 文件名
+const wasmIndexHTML = `<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<title>gogogo wasm harness</title>
+	<script src="wasm_exec.js"></script>
+	<script>
+		const go = new Go();
+		WebAssembly.instantiateStreaming(fetch(%q), go.importObject)
+			.then((result) => go.run(result.instance));
+	</script>
+</head>
+<body>打开控制台查看输出</body>
+</html>
+`
+
+// wasmRunScript wasip1/wasm 的 wasmtime 启动脚本模板
+const wasmRunScript = `#!/bin/sh
+# 由 gogogo --wasm-harness 生成
+exec wasmtime run "$(dirname "$0")/%s" "$@"
+`
+
+// writeWasmHarness 为 wasm 产物生成可直接试跑的测试载体：
+// js/wasm 输出 index.html + wasm_exec.js (浏览器里打开即可)，
+// wasip1/wasm 输出调用 wasmtime 的 run.sh
+func writeWasmHarness(cfg *Config, p platform.Platform, outPath string) error {
+	dir := filepath.Dir(outPath)
+	name := filepath.Base(outPath)
+	switch p.OS {
+	case "js":
+		execJS, err := findWasmExecJS()
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(execJS)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, "wasm_exec.js"), data, 0o644); err != nil {
+			return err
+		}
+		html := fmt.Sprintf(wasmIndexHTML, name)
+		if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte(html), 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("🌐 浏览器载体: %s\n", filepath.Join(dir, "index.html"))
+	case "wasip1":
+		script := fmt.Sprintf(wasmRunScript, name)
+		runPath := filepath.Join(dir, "run.sh")
+		if err := os.WriteFile(runPath, []byte(script), 0o755); err != nil {
+			return err
+		}
+		if _, err := exec.LookPath("wasmtime"); err != nil {
+			fmt.Println("⚠️  未找到 wasmtime，run.sh 需要先安装才能执行")
+		}
+		fmt.Printf("🌐 wasmtime 载体: %s\n", runPath)
+	}
+	return nil
+}
+
+// findWasmExecJS 定位 Go 发行版自带的 wasm_exec.js，
+// 路径随版本变化 (misc/wasm → lib/wasm)，两处都找
+func findWasmExecJS() (string, error) {
+	out, err := exec.Command("go", "env", "GOROOT").Output()
+	if err != nil {
+		return "", fmt.Errorf("无法确定 GOROOT: %w", err)
+	}
+	goroot := strings.TrimSpace(string(out))
+	for _, rel := range []string{
+		filepath.Join("lib", "wasm", "wasm_exec.js"),
+		filepath.Join("misc", "wasm", "wasm_exec.js"),
+	} {
+		path := filepath.Join(goroot, rel)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("GOROOT 下未找到 wasm_exec.js")
+}
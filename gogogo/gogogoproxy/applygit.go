@@ -0,0 +1,152 @@
+package gogogoproxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	applyGitProxy string
+	applyGitLocal bool
+	applyGitUndo  bool
+)
+
+// appliedGitState 记录本工具写过的 insteadOf 条目，undo 时精确清除
+type appliedGitState struct {
+	// Proxies 已应用的代理地址
+	Proxies []string `json:"proxies"`
+}
+
+// gitStatePath 状态文件与 sources.json 同目录
+func gitStatePath() string {
+	return filepath.Join(filepath.Dir(configPath()), "applied-git.json")
+}
+
+var applyGitCmd = &cobra.Command{
+	Use:   "apply-git",
+	Short: "🔧 写入 git 的 insteadOf 配置走代理克隆",
+	Long: `把 url.<代理>/https://github.com/.insteadOf 写进 git 配置
+(默认全局，--local 写当前仓库)，之后 git clone github 地址
+自动走代理；--undo 精确移除本工具写过的条目。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if applyGitUndo {
+			return undoGitProxy()
+		}
+		proxy := applyGitProxy
+		if proxy == "" {
+			best, err := bestProxy()
+			if err != nil {
+				return err
+			}
+			proxy = best
+		}
+		return applyGitProxyURL(proxy)
+	},
+}
+
+// bestProxy 拉取并实测，返回延迟最低的可用代理
+func bestProxy() (string, error) {
+	proxies := loadProxies(loadSources(nil))
+	fmt.Printf("🌐 实测 %d 个代理...\n", len(proxies))
+	results := probeProxies(proxies, 8, 5*time.Second)
+	for _, r := range results {
+		if r.OK {
+			fmt.Printf("✅ 最优代理: %s (%dms)\n", r.Proxy.URL, r.Latency.Milliseconds())
+			return r.Proxy.URL, nil
+		}
+	}
+	return "", fmt.Errorf("没有可用的代理")
+}
+
+// gitConfigArgs 组装 git config 参数，--local 时省略 --global
+func gitConfigArgs(args ...string) []string {
+	if applyGitLocal {
+		return append([]string{"config"}, args...)
+	}
+	return append([]string{"config", "--global"}, args...)
+}
+
+// applyGitProxyURL 写入 insteadOf 条目并记入状态文件
+func applyGitProxyURL(proxy string) error {
+	section := fmt.Sprintf("url.%s/https://github.com/", proxy)
+	out, err := exec.Command("git",
+		gitConfigArgs(section+".insteadOf", "https://github.com/")...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("写入 git 配置失败: %v\n%s", err, out)
+	}
+	state := loadGitState()
+	found := false
+	for _, p := range state.Proxies {
+		if p == proxy {
+			found = true
+		}
+	}
+	if !found {
+		state.Proxies = append(state.Proxies, proxy)
+	}
+	if err := saveGitState(state); err != nil {
+		return err
+	}
+	fmt.Printf("✅ git 已配置走代理: %s (撤销用 apply-git --undo)\n", proxy)
+	return nil
+}
+
+// undoGitProxy 移除状态文件里记录的所有 insteadOf 条目
+func undoGitProxy() error {
+	state := loadGitState()
+	if len(state.Proxies) == 0 {
+		fmt.Println("✅ 没有本工具写入的 git 代理配置")
+		return nil
+	}
+	for _, proxy := range state.Proxies {
+		section := fmt.Sprintf("url.%s/https://github.com/", proxy)
+		// 条目可能已被手工删除，失败不中断
+		if out, err := exec.Command("git",
+			gitConfigArgs("--remove-section", section)...).CombinedOutput(); err != nil {
+			fmt.Printf("⚠️  移除 %s 失败: %s\n", section, out)
+		} else {
+			fmt.Printf("🧹 已移除 %s\n", proxy)
+		}
+	}
+	if err := saveGitState(&appliedGitState{}); err != nil {
+		return err
+	}
+	fmt.Println("✅ git 代理配置已撤销")
+	return nil
+}
+
+// loadGitState 读取状态文件，不存在返回空状态
+func loadGitState() *appliedGitState {
+	state := &appliedGitState{}
+	if data, err := os.ReadFile(gitStatePath()); err == nil {
+		_ = json.Unmarshal(data, state)
+	}
+	return state
+}
+
+// saveGitState 写回状态文件
+func saveGitState(state *appliedGitState) error {
+	path := gitStatePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func init() {
+	applyGitCmd.Flags().StringVar(&applyGitProxy, "proxy", "", "指定代理地址 (默认实测选最优)")
+	applyGitCmd.Flags().BoolVar(&applyGitLocal, "local", false, "写当前仓库配置而不是全局配置")
+	applyGitCmd.Flags().BoolVar(&applyGitUndo, "undo", false, "移除本工具写过的 insteadOf 条目")
+	rootCmd.AddCommand(applyGitCmd)
+}
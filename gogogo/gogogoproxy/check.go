@@ -0,0 +1,101 @@
+package gogogoproxy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	checkEnvFile   string
+	checkTimeout   time.Duration
+	checkUpdateGit bool
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "🩺 检查当前代理是否可用，失效时自动切换",
+	Long: `实测 gogogo.env 里当前配置的代理；失效时按
+GITHUB.PROXYES 的排名逐个尝试下一个可用代理并更新
+env 文件，--update-git 时同步改写 git 的 insteadOf 配置。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		envPath := checkEnvFile
+		if envPath == "" {
+			envPath = envFilePath()
+		}
+		current := getEnvLine(envPath, "GITHUB_PROXY")
+		if current == "" {
+			return fmt.Errorf("%s 里没有 GITHUB_PROXY，请先跑 gogogoproxy daemon --once", envPath)
+		}
+		res := probeOne(Proxy{URL: current}, checkTimeout)
+		if res.OK {
+			fmt.Printf("✅ 当前代理正常: %s (%dms)\n", current, res.Latency.Milliseconds())
+			return nil
+		}
+		fmt.Printf("❌ 当前代理失效: %s (%s)，尝试切换...\n", current, res.Err)
+		next, err := nextWorkingProxy(envPath, current)
+		if err != nil {
+			return err
+		}
+		if err := setEnvLine(envPath, "GITHUB_PROXY", next); err != nil {
+			return err
+		}
+		fmt.Printf("✅ 已切换到 %s\n", next)
+		if checkUpdateGit {
+			return applyGitProxyURL(next)
+		}
+		return nil
+	},
+}
+
+// getEnvLine 从 env 文件读取 export KEY=... 的值
+func getEnvLine(path, key string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	for _, l := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimPrefix(strings.TrimSpace(l), "export ")
+		if value, ok := strings.CutPrefix(trimmed, key+"="); ok {
+			return strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	return ""
+}
+
+// nextWorkingProxy 按 GITHUB.PROXYES 排名找下一个可用代理，
+// 列表不可用时退回完整的拉取加实测
+func nextWorkingProxy(envPath, current string) (string, error) {
+	listPath := filepath.Join(filepath.Dir(envPath), "GITHUB.PROXYES")
+	if data, err := os.ReadFile(listPath); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") || line == current {
+				continue
+			}
+			if res := probeOne(Proxy{URL: line}, checkTimeout); res.OK {
+				return line, nil
+			}
+		}
+		fmt.Println("⚠️  排名列表里没有可用代理，重新拉取...")
+	}
+	results := probeProxies(loadProxies(loadSources(nil)), 8, checkTimeout)
+	for _, r := range results {
+		if r.OK && r.Proxy.URL != current {
+			return r.Proxy.URL, nil
+		}
+	}
+	return "", fmt.Errorf("没有可用的代理")
+}
+
+func init() {
+	checkCmd.Flags().StringVar(&checkEnvFile, "env-file", "", "gogogo.env 路径 (默认模块目录或当前目录)")
+	checkCmd.Flags().DurationVar(&checkTimeout, "timeout", 5*time.Second, "单次探测超时")
+	checkCmd.Flags().BoolVar(&checkUpdateGit, "update-git", false, "切换后同步改写 git 的 insteadOf 配置")
+	rootCmd.AddCommand(checkCmd)
+}
@@ -0,0 +1,126 @@
+package gogogoproxy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	daemonInterval time.Duration
+	daemonEnvFile  string
+	daemonOnce     bool
+)
+
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "⏱️  定时刷新代理列表并改写配置文件",
+	Long: `按 --interval 周期刷新代理列表，把实测排名写进
+GITHUB.PROXYES、最优代理写进 gogogo.env，两者都是
+临时文件加改名的原子写。带锁文件防止重复启动，
+适合从模块的 service.sh 拉起。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		envPath := daemonEnvFile
+		if envPath == "" {
+			envPath = envFilePath()
+		}
+		if daemonOnce {
+			return refreshProxies(envPath)
+		}
+		lock := filepath.Join(filepath.Dir(envPath), "gogogoproxy.lock")
+		if err := acquireLock(lock); err != nil {
+			return err
+		}
+		defer os.Remove(lock)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		fmt.Printf("⏱️  代理刷新 daemon 已启动 (间隔 %s，锁 %s)\n", daemonInterval, lock)
+		ticker := time.NewTicker(daemonInterval)
+		defer ticker.Stop()
+		for {
+			if err := refreshProxies(envPath); err != nil {
+				fmt.Printf("⚠️  刷新失败: %v (下个周期重试)\n", err)
+			}
+			select {
+			case <-ctx.Done():
+				fmt.Println("👋 收到退出信号，daemon 停止")
+				return nil
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+// acquireLock 创建 pid 锁文件；持锁进程仍活着时拒绝启动
+func acquireLock(path string) error {
+	if data, err := os.ReadFile(path); err == nil {
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil {
+			if _, err := os.Stat(fmt.Sprintf("/proc/%d", pid)); err == nil {
+				return fmt.Errorf("已有 daemon 在运行 (pid %d，锁 %s)", pid, path)
+			}
+		}
+		// 遗留的死锁文件，直接接管
+		fmt.Printf("⚠️  清理遗留锁文件 %s\n", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(strconv.Itoa(os.Getpid())+"\n"), 0o644)
+}
+
+// refreshProxies 刷新一轮：实测排名写 GITHUB.PROXYES，最优写 gogogo.env
+func refreshProxies(envPath string) error {
+	proxies := loadProxies(loadSources(nil))
+	results := probeProxies(proxies, 8, 5*time.Second)
+	var ranked []string
+	for _, r := range results {
+		if r.OK {
+			ranked = append(ranked, r.Proxy.URL)
+		}
+	}
+	if len(ranked) == 0 {
+		return fmt.Errorf("没有可用的代理")
+	}
+	proxyList := filepath.Join(filepath.Dir(envPath), "GITHUB.PROXYES")
+	if err := writeProxyList(proxyList, ranked); err != nil {
+		return err
+	}
+	if err := setEnvLine(envPath, "GITHUB_PROXY", ranked[0]); err != nil {
+		return err
+	}
+	fmt.Printf("✅ %s 已刷新 %d 个代理，最优 %s\n",
+		time.Now().Format("15:04:05"), len(ranked), ranked[0])
+	return nil
+}
+
+// writeProxyList 原子写出按实测排名的代理列表
+func writeProxyList(path string, ranked []string) error {
+	var b strings.Builder
+	b.WriteString("# 由 gogogoproxy daemon 生成，按实测延迟排序，勿手工编辑\n")
+	fmt.Fprintf(&b, "# updated: %s\n", time.Now().Format(time.RFC3339))
+	for _, u := range ranked {
+		b.WriteString(u + "\n")
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func init() {
+	daemonCmd.Flags().DurationVar(&daemonInterval, "interval", 6*time.Hour, "刷新间隔")
+	daemonCmd.Flags().StringVar(&daemonEnvFile, "env-file", "", "gogogo.env 路径 (默认模块目录或当前目录)")
+	daemonCmd.Flags().BoolVar(&daemonOnce, "once", false, "只刷新一轮后退出")
+	rootCmd.AddCommand(daemonCmd)
+}
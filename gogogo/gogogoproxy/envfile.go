@@ -0,0 +1,115 @@
+package gogogoproxy
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// 托管区标记：两行之间的内容归 gogogoproxy 管理，
+// 用户在标记外的自定义行原样保留
+const (
+	envMarkerBegin = "# >>> gogogoproxy managed >>>"
+	envMarkerEnd   = "# <<< gogogoproxy managed <<<"
+)
+
+// envFilePath 返回 gogogo.env 的位置：
+// GOGOGO_ENV 环境变量 > 模块安装目录 > 当前目录
+func envFilePath() string {
+	if env := os.Getenv("GOGOGO_ENV"); env != "" {
+		return env
+	}
+	if dir := utils.ModuleDir(); dir != "" {
+		return dir + "/gogogo.env"
+	}
+	return "gogogo.env"
+}
+
+// setEnvLine 更新 env 文件托管区里的 export KEY=... 行。
+// 与托管键无关的用户行一概不动；与托管键同名的区外 export 行
+// (历史版本曾不带标记直接追加) 会被收编进托管区。
+// 先写临时文件再改名，避免模块开机 source 到半截内容
+func setEnvLine(path, key, value string) error {
+	user, managed := parseEnvFile(path)
+	managed[key] = value
+
+	// 收编区外同名行：留着会和托管区的新值一前一后互相覆盖
+	kept := user[:0]
+	for _, l := range user {
+		if k, _, ok := parseExportLine(strings.TrimSpace(l)); ok {
+			if _, dup := managed[k]; dup {
+				continue
+			}
+		}
+		kept = append(kept, l)
+	}
+	user = kept
+
+	keys := make([]string, 0, len(managed))
+	for k := range managed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, l := range user {
+		b.WriteString(l + "\n")
+	}
+	if len(user) > 0 && user[len(user)-1] != "" {
+		b.WriteString("\n")
+	}
+	b.WriteString(envMarkerBegin + "\n")
+	for _, k := range keys {
+		fmt.Fprintf(&b, "export %s=%q\n", k, managed[k])
+	}
+	b.WriteString(envMarkerEnd + "\n")
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(b.String()), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// parseEnvFile 把现有文件拆成用户行与托管键值，
+// 标记外的行不做解释，同名键的收编由 setEnvLine 完成
+func parseEnvFile(path string) (user []string, managed map[string]string) {
+	managed = map[string]string{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, managed
+	}
+	inManaged := false
+	for _, l := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		trimmed := strings.TrimSpace(l)
+		switch trimmed {
+		case envMarkerBegin:
+			inManaged = true
+			continue
+		case envMarkerEnd:
+			inManaged = false
+			continue
+		}
+		if inManaged {
+			if key, value, ok := parseExportLine(trimmed); ok {
+				managed[key] = value
+			}
+			continue
+		}
+		user = append(user, l)
+	}
+	return user, managed
+}
+
+// parseExportLine 解析 export KEY="VALUE" 行
+func parseExportLine(line string) (key, value string, ok bool) {
+	line = strings.TrimPrefix(line, "export ")
+	key, value, ok = strings.Cut(line, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return "", "", false
+	}
+	return strings.TrimSpace(key), strings.Trim(strings.TrimSpace(value), `"`), true
+}
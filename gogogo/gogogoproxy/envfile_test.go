@@ -0,0 +1,67 @@
+package gogogoproxy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetEnvLinePreservesUserLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gogogo.env")
+	userLine := "export MY_CUSTOM=keepme"
+	if err := os.WriteFile(path, []byte(userLine+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := setEnvLine(path, "GITHUB_PROXY", "https://a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := setEnvLine(path, "GOPROXY", "https://b,direct"); err != nil {
+		t.Fatal(err)
+	}
+	if err := setEnvLine(path, "GITHUB_PROXY", "https://c"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+	if !strings.Contains(content, userLine) {
+		t.Errorf("用户行被丢弃:\n%s", content)
+	}
+	if !strings.Contains(content, `export GITHUB_PROXY="https://c"`) {
+		t.Errorf("托管键未更新:\n%s", content)
+	}
+	if strings.Contains(content, "https://a") {
+		t.Errorf("旧值残留:\n%s", content)
+	}
+	if strings.Count(content, envMarkerBegin) != 1 || strings.Count(content, envMarkerEnd) != 1 {
+		t.Errorf("托管区标记数量不对:\n%s", content)
+	}
+}
+
+func TestSetEnvLineAbsorbsStrayManagedKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gogogo.env")
+	legacy := "export GOPROXY=\"https://old\"\nexport MY_CUSTOM=keepme\n"
+	if err := os.WriteFile(path, []byte(legacy), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := setEnvLine(path, "GOPROXY", "https://new,direct"); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+	if strings.Contains(content, "https://old") {
+		t.Errorf("托管区外的同名旧行未被收编:\n%s", content)
+	}
+	if strings.Count(content, "GOPROXY") != 1 {
+		t.Errorf("GOPROXY 应只剩托管区一处:\n%s", content)
+	}
+	if !strings.Contains(content, "export MY_CUSTOM=keepme") {
+		t.Errorf("无关用户行被误删:\n%s", content)
+	}
+}
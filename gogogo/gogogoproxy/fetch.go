@@ -0,0 +1,61 @@
+package gogogoproxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultAPI 默认的代理列表 API
+const defaultAPI = "https://api.akams.cn/github"
+
+// fetchClient 拉取代理列表用的客户端
+var fetchClient = &http.Client{Timeout: 30 * time.Second}
+
+// Proxy 一个 GitHub 加速代理
+type Proxy struct {
+	// URL 代理地址 (前缀式，如 https://gh.example.cn)
+	URL string `json:"url"`
+	// Server / Location API 自报的服务器与位置信息
+	Server   string `json:"server,omitempty"`
+	Location string `json:"location,omitempty"`
+	// APILatency / APISpeed API 自报的延迟 (ms) 与速度，仅供参考
+	APILatency int     `json:"latency,omitempty"`
+	APISpeed   float64 `json:"speed,omitempty"`
+}
+
+// apiResponse api.akams.cn 风格的响应结构
+type apiResponse struct {
+	Code int     `json:"code"`
+	Msg  string  `json:"msg"`
+	Data []Proxy `json:"data"`
+}
+
+// fetchProxies 从 API 拉取代理列表
+func fetchProxies(api string) ([]Proxy, error) {
+	resp, err := fetchClient.Get(api)
+	if err != nil {
+		return nil, fmt.Errorf("拉取代理列表失败 (%s): %w", api, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("拉取代理列表失败 (%s): HTTP %d", api, resp.StatusCode)
+	}
+	var parsed apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("代理列表格式错误 (%s): %w", api, err)
+	}
+	if parsed.Code != 0 && parsed.Code != http.StatusOK {
+		return nil, fmt.Errorf("代理 API 返回错误 (%s): %s", api, parsed.Msg)
+	}
+	var proxies []Proxy
+	for _, p := range parsed.Data {
+		p.URL = strings.TrimSuffix(strings.TrimSpace(p.URL), "/")
+		if strings.HasPrefix(p.URL, "http://") || strings.HasPrefix(p.URL, "https://") {
+			proxies = append(proxies, p)
+		}
+	}
+	return proxies, nil
+}
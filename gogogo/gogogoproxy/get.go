@@ -0,0 +1,135 @@
+package gogogoproxy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	getOutput string
+	getProxy  string
+	getSHA256 string
+)
+
+// getClient 下载客户端，大文件给足超时
+var getClient = &http.Client{Timeout: 30 * time.Minute}
+
+var getCmd = &cobra.Command{
+	Use:   "get <github-url>",
+	Short: "⬇️  经最优代理下载 GitHub 文件 (支持断点续传)",
+	Long: `把 Release 资产或 raw 文件地址交给实测最优的代理下载；
+中断后重跑会从 .part 文件断点续传，--sha256 下载完成后
+校验哈希，适合设备端的模块安装脚本。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rawURL := args[0]
+		if !strings.HasPrefix(rawURL, "https://") && !strings.HasPrefix(rawURL, "http://") {
+			return fmt.Errorf("需要完整的 GitHub 文件地址，得到 %q", rawURL)
+		}
+		output := getOutput
+		if output == "" {
+			output = path.Base(rawURL)
+		}
+		proxy := getProxy
+		if proxy == "" {
+			proxy = getEnvLine(envFilePath(), "GITHUB_PROXY")
+		}
+		if proxy == "" {
+			best, err := bestProxy()
+			if err != nil {
+				return err
+			}
+			proxy = best
+		}
+		if err := downloadWithResume(proxy+"/"+rawURL, output); err != nil {
+			return err
+		}
+		if getSHA256 != "" {
+			if err := verifyFileSHA256(output, getSHA256); err != nil {
+				return err
+			}
+			fmt.Println("✅ 校验和一致")
+		}
+		fmt.Printf("✅ 已下载 %s\n", output)
+		return nil
+	},
+}
+
+// downloadWithResume 下载到 <输出>.part，支持 Range 断点续传，完成后改名
+func downloadWithResume(url, output string) error {
+	part := output + ".part"
+	var offset int64
+	if info, err := os.Stat(part); err == nil {
+		offset = info.Size()
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		fmt.Printf("⏯️  从 %.2f MB 处续传\n", float64(offset)/1024/1024)
+	}
+	resp, err := getClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("下载失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+	case http.StatusOK:
+		// 服务器不支持 Range，从头来过
+		offset = 0
+		flags |= os.O_TRUNC
+	default:
+		return fmt.Errorf("下载失败: HTTP %d", resp.StatusCode)
+	}
+	f, err := os.OpenFile(part, flags, 0o644)
+	if err != nil {
+		return err
+	}
+	written, err := io.Copy(f, resp.Body)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("下载中断 (已保留 %s，可重跑续传): %w", part, err)
+	}
+	fmt.Printf("⬇️  %.2f MB\n", float64(offset+written)/1024/1024)
+	return os.Rename(part, output)
+}
+
+// verifyFileSHA256 校验文件的 SHA-256
+func verifyFileSHA256(path, expected string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	actual := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("❌ 校验和不一致:\n  期望 %s\n  实际 %s", expected, actual)
+	}
+	return nil
+}
+
+func init() {
+	getCmd.Flags().StringVarP(&getOutput, "output", "o", "", "输出文件名 (默认 URL 末段)")
+	getCmd.Flags().StringVar(&getProxy, "proxy", "", "指定代理 (默认 gogogo.env 配置或实测最优)")
+	getCmd.Flags().StringVar(&getSHA256, "sha256", "", "下载后校验的 SHA-256")
+	rootCmd.AddCommand(getCmd)
+}
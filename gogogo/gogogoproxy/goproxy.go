@@ -0,0 +1,165 @@
+package gogogoproxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultGoProxies 待实测的 Go 模块代理
+var defaultGoProxies = []string{
+	"https://goproxy.cn",
+	"https://goproxy.io",
+	"https://proxy.golang.org",
+	"https://mirrors.aliyun.com/goproxy",
+}
+
+// goproxyProbePath 实测用的已知模块版本列表
+const goproxyProbePath = "/github.com/spf13/cobra/@v/list"
+
+var (
+	goproxyMirrors []string
+	goproxyTimeout time.Duration
+	goproxyWrite   bool
+	goproxyEnvFile string
+	goproxyTop     int
+)
+
+// goproxyResult 单个 Go 模块代理的实测结果
+type goproxyResult struct {
+	URL string
+	// TTFB 到首字节的耗时
+	TTFB time.Duration
+	OK   bool
+	Err  string
+}
+
+var goproxyCmd = &cobra.Command{
+	Use:   "goproxy",
+	Short: "📦 实测 Go 模块代理并生成 GOPROXY 配置",
+	Long: `对 goproxy.cn/goproxy.io/proxy.golang.org 及 --mirror
+指定的镜像实测已知模块的首字节耗时，按结果排序；
+--write 时把有序的 GOPROXY 行写进 gogogo.env，
+取代拍脑袋的固定顺序。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		candidates := append(append([]string{}, defaultGoProxies...), goproxyMirrors...)
+		infof("📦 实测 %d 个 Go 模块代理...\n", len(candidates))
+		results := benchGoProxies(candidates, goproxyTimeout)
+
+		var ordered []string
+		for i, r := range results {
+			if !r.OK {
+				infof("  ❌ %-40s %s\n", r.URL, r.Err)
+				continue
+			}
+			infof("  %2d. %-40s %6dms\n", i+1, r.URL, r.TTFB.Milliseconds())
+			if len(ordered) < goproxyTop {
+				ordered = append(ordered, r.URL)
+			}
+		}
+		if len(ordered) == 0 {
+			return fmt.Errorf("没有可用的 Go 模块代理")
+		}
+		line := ""
+		for _, u := range ordered {
+			line += u + ","
+		}
+		line += "direct"
+		if jsonOutput() {
+			type benchJSON struct {
+				URL    string `json:"url"`
+				TTFBMS int64  `json:"ttfb_ms"`
+				OK     bool   `json:"ok"`
+				Error  string `json:"error,omitempty"`
+			}
+			out := struct {
+				GoProxy string      `json:"goproxy"`
+				Results []benchJSON `json:"results"`
+			}{GoProxy: line}
+			for _, r := range results {
+				out.Results = append(out.Results, benchJSON{
+					URL: r.URL, TTFBMS: r.TTFB.Milliseconds(), OK: r.OK, Error: r.Err})
+			}
+			if err := emitJSON(out); err != nil {
+				return err
+			}
+		} else if flagQuiet {
+			fmt.Println(line)
+		} else {
+			fmt.Printf("✅ GOPROXY=%s\n", line)
+		}
+		if goproxyWrite {
+			path := goproxyEnvFile
+			if path == "" {
+				path = envFilePath()
+			}
+			if err := setEnvLine(path, "GOPROXY", line); err != nil {
+				return err
+			}
+			infof("✅ 已写入 %s\n", path)
+		}
+		return nil
+	},
+}
+
+// benchGoProxies 并发实测所有代理的首字节耗时，按成功与耗时排序
+func benchGoProxies(urls []string, timeout time.Duration) []goproxyResult {
+	results := make([]goproxyResult, len(urls))
+	var wg sync.WaitGroup
+	for i, u := range urls {
+		wg.Add(1)
+		go func(i int, u string) {
+			defer wg.Done()
+			results[i] = benchGoProxy(u, timeout)
+		}(i, u)
+	}
+	wg.Wait()
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].OK != results[j].OK {
+			return results[i].OK
+		}
+		return results[i].TTFB < results[j].TTFB
+	})
+	return results
+}
+
+// benchGoProxy 请求已知模块的版本列表并测到首字节的耗时
+func benchGoProxy(url string, timeout time.Duration) goproxyResult {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+goproxyProbePath, nil)
+	if err != nil {
+		return goproxyResult{URL: url, Err: err.Error()}
+	}
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return goproxyResult{URL: url, Err: err.Error()}
+	}
+	defer resp.Body.Close()
+	buf := make([]byte, 1)
+	_, readErr := resp.Body.Read(buf)
+	elapsed := time.Since(start)
+	if resp.StatusCode != http.StatusOK {
+		return goproxyResult{URL: url, TTFB: elapsed, Err: resp.Status}
+	}
+	if readErr != nil {
+		return goproxyResult{URL: url, TTFB: elapsed, Err: readErr.Error()}
+	}
+	return goproxyResult{URL: url, TTFB: elapsed, OK: true}
+}
+
+func init() {
+	goproxyCmd.Flags().StringSliceVar(&goproxyMirrors, "mirror", nil, "额外实测的自定义镜像地址")
+	goproxyCmd.Flags().DurationVar(&goproxyTimeout, "timeout", 10*time.Second, "单个代理的实测超时")
+	goproxyCmd.Flags().BoolVar(&goproxyWrite, "write", false, "把有序 GOPROXY 行写进 gogogo.env")
+	goproxyCmd.Flags().StringVar(&goproxyEnvFile, "env-file", "", "gogogo.env 路径 (默认模块目录或当前目录)")
+	goproxyCmd.Flags().IntVar(&goproxyTop, "top", 3, "写入 GOPROXY 的代理个数")
+	rootCmd.AddCommand(goproxyCmd)
+}
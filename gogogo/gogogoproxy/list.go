@@ -0,0 +1,77 @@
+package gogogoproxy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	listSources     []string
+	listTop         int
+	listConcurrency int
+	listTimeout     time.Duration
+	listNoProbe     bool
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "📋 拉取代理列表并按实测延迟排名",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		proxies := loadProxies(loadSources(listSources))
+		infof("🌐 获取到 %d 个代理，实测中 (并发 %d，超时 %s)...\n",
+			len(proxies), listConcurrency, listTimeout)
+		if listNoProbe {
+			if jsonOutput() {
+				return emitJSON(proxies)
+			}
+			for i, p := range proxies {
+				if i >= listTop {
+					break
+				}
+				fmt.Printf("  %-40s API 自报 %dms\n", p.URL, p.APILatency)
+			}
+			return nil
+		}
+		results := probeProxies(proxies, listConcurrency, listTimeout)
+		if jsonOutput() {
+			return emitJSON(toJSONResults(results))
+		}
+		printResults(results, listTop)
+		return nil
+	},
+}
+
+// printResults 打印实测排名表
+func printResults(results []ProbeResult, top int) {
+	ok := 0
+	for _, r := range results {
+		if r.OK {
+			ok++
+		}
+	}
+	fmt.Printf("✅ %d/%d 个代理可用:\n", ok, len(results))
+	shown := 0
+	for _, r := range results {
+		if !r.OK {
+			continue
+		}
+		if shown >= top {
+			break
+		}
+		shown++
+		fmt.Printf("  %2d. %-40s %6dms  %s\n",
+			shown, r.Proxy.URL, r.Latency.Milliseconds(), r.Proxy.Location)
+	}
+}
+
+func init() {
+	listCmd.Flags().StringSliceVar(&listSources, "source", nil, "代理列表 API 地址，可多次指定 (默认 sources.json 或内置 API)")
+	listCmd.Flags().IntVar(&listTop, "top", 10, "显示前 N 名")
+	listCmd.Flags().IntVar(&listConcurrency, "concurrency", 8, "实测并发数")
+	listCmd.Flags().DurationVar(&listTimeout, "timeout", 5*time.Second, "单个代理的实测超时")
+	listCmd.Flags().BoolVar(&listNoProbe, "no-probe", false, "跳过实测，直接按 API 顺序显示")
+	rootCmd.AddCommand(listCmd)
+}
@@ -0,0 +1,61 @@
+package gogogoproxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+var (
+	// flagFormat 输出格式: text (默认，带表情符号) 或 json
+	flagFormat string
+	// flagQuiet 安静模式：只输出结果，过程提示全部省略
+	flagQuiet bool
+)
+
+// jsonOutput 是否为机器可读输出模式
+func jsonOutput() bool { return flagFormat == "json" }
+
+// infof 过程提示：--quiet 或 JSON 模式下不输出，避免污染脚本管道
+func infof(format string, args ...any) {
+	if flagQuiet || jsonOutput() {
+		return
+	}
+	fmt.Printf(format, args...)
+}
+
+// emitJSON 把结果以 JSON 写到标准输出
+func emitJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// probeResultJSON ProbeResult 的脚本友好形式
+type probeResultJSON struct {
+	URL       string `json:"url"`
+	LatencyMS int64  `json:"latency_ms"`
+	OK        bool   `json:"ok"`
+	Error     string `json:"error,omitempty"`
+	Location  string `json:"location,omitempty"`
+}
+
+// toJSONResults 转换实测结果列表
+func toJSONResults(results []ProbeResult) []probeResultJSON {
+	out := make([]probeResultJSON, 0, len(results))
+	for _, r := range results {
+		out = append(out, probeResultJSON{
+			URL:       r.Proxy.URL,
+			LatencyMS: r.Latency.Milliseconds(),
+			OK:        r.OK,
+			Error:     r.Err,
+			Location:  r.Proxy.Location,
+		})
+	}
+	return out
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&flagFormat, "format", "text", "输出格式: text 或 json")
+	rootCmd.PersistentFlags().BoolVarP(&flagQuiet, "quiet", "q", false, "安静模式，只输出结果")
+}
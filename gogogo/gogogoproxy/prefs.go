@@ -0,0 +1,103 @@
+package gogogoproxy
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"strings"
+)
+
+var (
+	// flagPin 置顶的代理域名：只要可用就排在实测排名之前
+	flagPin []string
+	// flagDeny 拉黑的代理域名：公司网络常屏蔽个别镜像
+	flagDeny []string
+	// flagPreferRegions 偏好地区：Location 命中的代理优先
+	flagPreferRegions []string
+)
+
+// proxyPrefs 代理偏好：置顶、拉黑与地区偏好。
+// 命令行标志与 sources.json 里的同名字段合并生效
+type proxyPrefs struct {
+	Pin           []string `json:"pin"`
+	Deny          []string `json:"deny"`
+	PreferRegions []string `json:"prefer_regions"`
+}
+
+// loadPrefs 合并 sources.json 与命令行标志里的偏好
+func loadPrefs() proxyPrefs {
+	prefs := proxyPrefs{}
+	if path := configPath(); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			_ = json.Unmarshal(data, &prefs)
+		}
+	}
+	prefs.Pin = append(prefs.Pin, flagPin...)
+	prefs.Deny = append(prefs.Deny, flagDeny...)
+	prefs.PreferRegions = append(prefs.PreferRegions, flagPreferRegions...)
+	return prefs
+}
+
+// domainMatch 代理地址的主机名等于 domain 或是其子域名
+func domainMatch(proxyURL, domain string) bool {
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Hostname() == "" {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	domain = strings.ToLower(strings.TrimPrefix(domain, "www."))
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+// denied 代理是否被拉黑
+func (prefs proxyPrefs) denied(p Proxy) bool {
+	for _, d := range prefs.Deny {
+		if domainMatch(p.URL, d) {
+			return true
+		}
+	}
+	return false
+}
+
+// rank 偏好等级：0 置顶，1 地区偏好，2 其余。
+// 实测排序先按等级再按延迟，确保偏好优先于速度
+func (prefs proxyPrefs) rank(p Proxy) int {
+	for _, d := range prefs.Pin {
+		if domainMatch(p.URL, d) {
+			return 0
+		}
+	}
+	for _, region := range prefs.PreferRegions {
+		if region != "" && strings.Contains(
+			strings.ToLower(p.Location), strings.ToLower(region)) {
+			return 1
+		}
+	}
+	return 2
+}
+
+// filterDenied 过滤拉黑的代理
+func (prefs proxyPrefs) filterDenied(proxies []Proxy) []Proxy {
+	if len(prefs.Deny) == 0 {
+		return proxies
+	}
+	kept := proxies[:0]
+	dropped := 0
+	for _, p := range proxies {
+		if prefs.denied(p) {
+			dropped++
+			continue
+		}
+		kept = append(kept, p)
+	}
+	if dropped > 0 {
+		infof("🚫 已按拉黑列表剔除 %d 个代理\n", dropped)
+	}
+	return kept
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringSliceVar(&flagPin, "pin", nil, "置顶的代理域名，可用时优先于实测排名")
+	rootCmd.PersistentFlags().StringSliceVar(&flagDeny, "deny", nil, "拉黑的代理域名，直接剔除")
+	rootCmd.PersistentFlags().StringSliceVar(&flagPreferRegions, "prefer-region", nil, "偏好地区，Location 命中的代理优先")
+}
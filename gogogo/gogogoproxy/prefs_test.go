@@ -0,0 +1,42 @@
+package gogogoproxy
+
+import "testing"
+
+func TestDomainMatch(t *testing.T) {
+	cases := []struct {
+		url, domain string
+		want        bool
+	}{
+		{"https://ghproxy.net", "ghproxy.net", true},
+		{"https://cdn.ghproxy.net", "ghproxy.net", true},
+		{"https://ghproxy.net.evil.com", "ghproxy.net", false},
+		{"https://gh-proxy.com", "ghproxy.net", false},
+	}
+	for _, c := range cases {
+		if got := domainMatch(c.url, c.domain); got != c.want {
+			t.Errorf("domainMatch(%q, %q) = %v, want %v", c.url, c.domain, got, c.want)
+		}
+	}
+}
+
+func TestPrefsRankAndDeny(t *testing.T) {
+	prefs := proxyPrefs{
+		Pin:           []string{"ghfast.top"},
+		Deny:          []string{"gh.ddlc.top"},
+		PreferRegions: []string{"香港"},
+	}
+	pinned := Proxy{URL: "https://ghfast.top", Location: "美国"}
+	regional := Proxy{URL: "https://gh-proxy.com", Location: "中国香港"}
+	plain := Proxy{URL: "https://ghproxy.net", Location: "德国"}
+	if prefs.rank(pinned) != 0 || prefs.rank(regional) != 1 || prefs.rank(plain) != 2 {
+		t.Errorf("偏好等级不对: pin=%d region=%d plain=%d",
+			prefs.rank(pinned), prefs.rank(regional), prefs.rank(plain))
+	}
+	if !prefs.denied(Proxy{URL: "https://gh.ddlc.top"}) {
+		t.Error("拉黑域名未被识别")
+	}
+	kept := prefs.filterDenied([]Proxy{pinned, {URL: "https://gh.ddlc.top"}, plain})
+	if len(kept) != 2 {
+		t.Errorf("filterDenied 保留 %d 个，期望 2", len(kept))
+	}
+}
@@ -0,0 +1,77 @@
+package gogogoproxy
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// probeTarget 实测用的已知小文件：经代理取 GitHub raw 内容
+const probeTarget = "https://raw.githubusercontent.com/LIghtJUNction/RootManage-Module-Model/main/README.md"
+
+// ProbeResult 单个代理的实测结果
+type ProbeResult struct {
+	Proxy Proxy `json:"proxy"`
+	// Latency 实测往返耗时，失败时无意义
+	Latency time.Duration `json:"latency_ms"`
+	// OK 探测是否成功 (HTTP 2xx/3xx 且未超时)
+	OK bool `json:"ok"`
+	// Err 失败原因
+	Err string `json:"error,omitempty"`
+}
+
+// probeProxies 并发实测所有代理，按成功、偏好与延迟排名返回。
+// API 自报的 speed/latency 经常与用户网络不符，一律以实测为准；
+// 置顶与地区偏好 (见 prefs.go) 优先于实测延迟
+func probeProxies(proxies []Proxy, concurrency int, timeout time.Duration) []ProbeResult {
+	if concurrency < 1 {
+		concurrency = 8
+	}
+	prefs := loadPrefs()
+	results := make([]ProbeResult, len(proxies))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, p := range proxies {
+		wg.Add(1)
+		go func(i int, p Proxy) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = probeOne(p, timeout)
+		}(i, p)
+	}
+	wg.Wait()
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].OK != results[j].OK {
+			return results[i].OK
+		}
+		if ri, rj := prefs.rank(results[i].Proxy), prefs.rank(results[j].Proxy); ri != rj {
+			return ri < rj
+		}
+		return results[i].Latency < results[j].Latency
+	})
+	return results
+}
+
+// probeOne 对单个代理发一次 HEAD 请求并计时
+func probeOne(p Proxy, timeout time.Duration) ProbeResult {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, p.URL+"/"+probeTarget, nil)
+	if err != nil {
+		return ProbeResult{Proxy: p, Err: err.Error()}
+	}
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return ProbeResult{Proxy: p, Err: err.Error()}
+	}
+	resp.Body.Close()
+	elapsed := time.Since(start)
+	if resp.StatusCode >= 400 {
+		return ProbeResult{Proxy: p, Latency: elapsed, Err: resp.Status}
+	}
+	return ProbeResult{Proxy: p, Latency: elapsed, OK: true}
+}
@@ -0,0 +1,30 @@
+// Package gogogoproxy 实现 GitHub 加速代理的获取、实测与应用。
+package gogogoproxy
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// Version 由 main 包注入
+var Version = "dev"
+
+var rootCmd = &cobra.Command{
+	Use:   "gogogoproxy",
+	Short: "🌐 GitHub 加速代理管理器",
+	Long: `gogogoproxy 从公开 API 拉取 GitHub 加速代理列表，
+用本机网络实测延迟排名 (API 自报的速度经常不符合实际)，
+并把最优代理写进 gogogo 与 git 的配置。`,
+	SilenceUsage: true,
+}
+
+// Execute 运行 gogogoproxy 命令树
+func Execute() error {
+	rootCmd.Version = Version
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return err
+	}
+	return nil
+}
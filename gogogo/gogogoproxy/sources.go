@@ -0,0 +1,85 @@
+package gogogoproxy
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// builtinProxies 内置的兜底代理列表：所有 API 都不可用时仍能工作。
+// 列表只求可用性，排序交给实测
+var builtinProxies = []Proxy{
+	{URL: "https://ghproxy.net"},
+	{URL: "https://gh-proxy.com"},
+	{URL: "https://ghfast.top"},
+	{URL: "https://gh.ddlc.top"},
+	{URL: "https://gh.qninq.cn"},
+}
+
+// sourcesConfig sources.json 的结构
+type sourcesConfig struct {
+	// Sources 代理列表 API 地址，按顺序尝试并合并结果
+	Sources []string `json:"sources"`
+}
+
+// configPath 返回 gogogoproxy 的配置文件路径
+func configPath() string {
+	if env := os.Getenv("GOGOGOPROXY_CONFIG"); env != "" {
+		return env
+	}
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		base = filepath.Join(home, ".config")
+	}
+	return filepath.Join(base, "gogogoproxy", "sources.json")
+}
+
+// loadSources 决定本次使用的 API 列表：
+// 命令行 --source > sources.json > 默认 API
+func loadSources(flagSources []string) []string {
+	if len(flagSources) > 0 {
+		return flagSources
+	}
+	if path := configPath(); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			var cfg sourcesConfig
+			if err := json.Unmarshal(data, &cfg); err == nil && len(cfg.Sources) > 0 {
+				return cfg.Sources
+			}
+			infof("⚠️  %s 无效，使用默认 API\n", path)
+		}
+	}
+	return []string{defaultAPI}
+}
+
+// loadProxies 从多个 API 拉取并合并去重；全部失败时退回内置列表
+func loadProxies(sources []string) []Proxy {
+	seen := map[string]bool{}
+	var merged []Proxy
+	failed := 0
+	for _, api := range sources {
+		proxies, err := fetchProxies(api)
+		if err != nil {
+			infof("⚠️  %v\n", err)
+			failed++
+			continue
+		}
+		for _, p := range proxies {
+			if !seen[p.URL] {
+				seen[p.URL] = true
+				merged = append(merged, p)
+			}
+		}
+	}
+	if len(merged) == 0 {
+		infof("⚠️  %d 个代理源全部失效，使用内置兜底列表\n", failed)
+		merged = append(merged, builtinProxies...)
+	} else if failed > 0 {
+		infof("⚠️  %d/%d 个代理源失效，已合并其余来源\n", failed, len(sources))
+	}
+	return loadPrefs().filterDenied(merged)
+}
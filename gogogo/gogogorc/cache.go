@@ -0,0 +1,73 @@
+package gogogorc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// cacheHeader 缓存文件首行前缀，后跟输入哈希
+const cacheHeader = "# gogogorc-cache "
+
+// cachePath 缓存文件位置，--cache-file 可覆盖
+func cachePath(cfg *rcConfig) string {
+	if flagCacheFile != "" {
+		return flagCacheFile
+	}
+	return filepath.Join(cfg.ModuleDir, "cache", "gogogorc.cache")
+}
+
+// inputHash 汇总所有影响输出的输入：PATH、配置文件、gogogo.dev、
+// 模块目录、输出格式与工具版本。任何一项变化都会使缓存失效
+func inputHash(cfg *rcConfig, configPath, format string, asJSON bool) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "version=%s\n", Version)
+	fmt.Fprintf(h, "path=%s\n", os.Getenv("PATH"))
+	fmt.Fprintf(h, "moduledir=%s\n", cfg.ModuleDir)
+	fmt.Fprintf(h, "format=%s json=%v profile=%s\n", format, asJSON, flagProfile)
+	if data, err := os.ReadFile(configPath); err == nil {
+		h.Write(data)
+	}
+	h.Write([]byte{0})
+	if data, err := os.ReadFile(filepath.Join(cfg.ModuleDir, "gogogo.dev")); err == nil {
+		h.Write(data)
+	} else {
+		h.Write([]byte("no-dev"))
+	}
+	// 目录存在与否影响导出结果 (缺失项会被省略)，一并纳入哈希
+	for _, e := range cfg.Exports {
+		dir := cfg.expand(e.Value)
+		_, err := os.Stat(dir)
+		fmt.Fprintf(h, "dir=%s exists=%v\n", dir, err == nil)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// readCache 读取缓存，哈希一致时返回缓存的输出块
+func readCache(path, hash string) (string, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	header, block, found := strings.Cut(string(data), "\n")
+	if !found || header != cacheHeader+hash {
+		return "", false
+	}
+	return block, true
+}
+
+// writeCache 原子写入缓存：先写临时文件再改名
+func writeCache(path, hash, block string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	content := cacheHeader + hash + "\n" + block
+	if err := os.WriteFile(tmp, []byte(content), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
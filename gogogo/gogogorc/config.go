@@ -0,0 +1,175 @@
+package gogogorc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// defaultModuleDir 模块的标准安装位置
+const defaultModuleDir = "/data/adb/modules/gogogo"
+
+// export 单个导出项，保持配置文件里的书写顺序
+type export struct {
+	Key   string
+	Value string
+}
+
+// rcConfig 本次输出的全部内容
+type rcConfig struct {
+	// ModuleDir 模块目录，${MODDIR} 展开为该值
+	ModuleDir string
+	// Exports 待导出的变量，按声明顺序
+	Exports []export
+	// PathPin PATH 里提前的子串模式，来自 [path] pin
+	PathPin []string
+	// PathDeny PATH 里剔除的子串模式，来自 [path] deny
+	PathDeny []string
+	// Profiles 配置文件定义的 path profile，覆盖同名内置项
+	Profiles map[string]pathProfile
+}
+
+// defaultExports 内置默认值，与模块安装脚本铺设的目录一致。
+// 配置文件里的 [exports] 节整体取代这份列表
+func defaultExports() []export {
+	return []export{
+		{"GOROOT", "${MODDIR}/go"},
+		{"GOPATH", "${MODDIR}/gopath"},
+		{"GOBIN", "${MODDIR}/bin"},
+		{"GOXBIN", "${MODDIR}/xbin"},
+		{"GOCACHE", "${MODDIR}/cache/go-build"},
+		{"GOTMPDIR", "${MODDIR}/tmp"},
+	}
+}
+
+// loadConfig 决定模块目录并读取 gogogorc.toml，
+// 返回实际使用的配置文件路径 (没有配置文件时为空)。
+// 优先级：命令行 > 环境变量 GOGOGORC_CONFIG > 模块目录下的文件 > 内置默认
+func loadConfig(configPath, moduleDir string) (*rcConfig, string, error) {
+	if moduleDir == "" {
+		if dir := utils.ModuleDir(); dir != "" {
+			moduleDir = dir
+		} else {
+			moduleDir = defaultModuleDir
+		}
+	}
+	cfg := &rcConfig{
+		ModuleDir: moduleDir,
+		Exports:   defaultExports(),
+		Profiles:  map[string]pathProfile{},
+	}
+	if configPath == "" {
+		configPath = os.Getenv("GOGOGORC_CONFIG")
+	}
+	if configPath == "" {
+		candidate := filepath.Join(moduleDir, "gogogorc.toml")
+		if _, err := os.Stat(candidate); err != nil {
+			return cfg, "", nil
+		}
+		configPath = candidate
+	}
+	if err := parseConfigFile(configPath, cfg); err != nil {
+		return nil, "", err
+	}
+	return cfg, configPath, nil
+}
+
+// parseConfigFile 解析 gogogorc.toml。只认本工具用到的最小子集：
+// 顶层 module_dir 与 [exports] 节里的 KEY = "value" 行，
+// 不为此引入完整的 TOML 依赖
+func parseConfigFile(path string, cfg *rcConfig) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	section := ""
+	var exports []export
+	for lineNo, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		key, value, err := parseAssignment(line)
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", path, lineNo+1, err)
+		}
+		switch {
+		case section == "":
+			if key == "module_dir" {
+				cfg.ModuleDir = value
+			}
+		case section == "exports":
+			exports = append(exports, export{Key: key, Value: value})
+		case section == "path":
+			switch key {
+			case "pin":
+				cfg.PathPin = splitList(value)
+			case "deny":
+				cfg.PathDeny = splitList(value)
+			}
+		case strings.HasPrefix(section, "profiles."):
+			name := strings.TrimPrefix(section, "profiles.")
+			p := cfg.Profiles[name]
+			switch key {
+			case "extends":
+				p.Extends = value
+			case "bins":
+				p.Bins = splitList(value)
+			case "front":
+				p.Front = value == "true"
+				p.frontSet = true
+			}
+			cfg.Profiles[name] = p
+		}
+	}
+	if len(exports) > 0 {
+		cfg.Exports = exports
+	}
+	return nil
+}
+
+// parseAssignment 解析 KEY = "value" 行，值必须带双引号
+func parseAssignment(line string) (string, string, error) {
+	key, value, found := strings.Cut(line, "=")
+	if !found {
+		return "", "", fmt.Errorf("无法解析的行: %s", line)
+	}
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return "", "", fmt.Errorf("值必须用双引号包裹: %s", line)
+	}
+	return key, value[1 : len(value)-1], nil
+}
+
+// splitList 拆分逗号分隔的列表值
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// expand 展开值里的 ${MODDIR} 占位符
+func (cfg *rcConfig) expand(value string) string {
+	return strings.ReplaceAll(value, "${MODDIR}", cfg.ModuleDir)
+}
+
+// expandRefs 额外展开对导出变量的引用 (${GOROOT} 等)
+func (cfg *rcConfig) expandRefs(value string) string {
+	value = cfg.expand(value)
+	for _, e := range cfg.Exports {
+		value = strings.ReplaceAll(value, "${"+e.Key+"}", cfg.expand(e.Value))
+	}
+	return value
+}
@@ -0,0 +1,88 @@
+package gogogorc
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// emit 输出全部赋值语句。顺序：配置的变量在前，PATH 最后，
+// 这样 PATH 里可以引用前面刚导出的目录
+func emit(w io.Writer, cfg *rcConfig, format string, asJSON bool) error {
+	resolved, err := resolveExports(cfg)
+	if err != nil {
+		return err
+	}
+	if asJSON {
+		return renderJSON(w, resolved)
+	}
+	for _, e := range resolved {
+		if err := renderExport(w, format, e.Key, e.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveExports 展开全部变量并算出最终 PATH
+func resolveExports(cfg *rcConfig) ([]export, error) {
+	resolved := make([]export, 0, len(cfg.Exports)+1)
+	for _, e := range cfg.Exports {
+		value := cfg.expand(e.Value)
+		if !verifyExportDir(e.Key, value) {
+			continue
+		}
+		resolved = append(resolved, export{Key: e.Key, Value: value})
+	}
+	profile, err := resolveProfile(cfg, profileName(cfg))
+	if err != nil {
+		return nil, err
+	}
+	pol := pathPolicy{Pin: cfg.PathPin, Deny: cfg.PathDeny}
+	path, notes := assemblePathExplain(os.Getenv("PATH"), profileBins(cfg, profile), profile.Front, pol)
+	if flagExplain {
+		fmt.Fprintf(os.Stderr, "🔍 PATH 整理过程 (%d 步):\n", len(notes))
+		for _, note := range notes {
+			fmt.Fprintf(os.Stderr, "  %s\n", note)
+		}
+	}
+	return append(resolved, export{Key: "PATH", Value: path}), nil
+}
+
+// creatableDirs 允许缺失时现场创建的目录：都是运行期可再生的
+var creatableDirs = map[string]bool{
+	"GOCACHE":  true,
+	"GOTMPDIR": true,
+	"GOTMP":    true,
+}
+
+// verifyExportDir 检查目录型导出项。缺失的缓存/临时目录直接补建；
+// 其余目录缺失说明模块没装完整，警告并省略该项，
+// 免得半套安装把 shell 环境弄脏
+func verifyExportDir(key, value string) bool {
+	if !strings.HasPrefix(value, "/") {
+		return true
+	}
+	if info, err := os.Stat(value); err == nil {
+		if info.IsDir() {
+			return true
+		}
+		fmt.Fprintf(os.Stderr, "⚠️  %s=%s 不是目录，已省略\n", key, value)
+		return false
+	}
+	if creatableDirs[key] {
+		if err := os.MkdirAll(value, 0o755); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  无法创建 %s=%s: %v，已省略\n", key, value, err)
+			return false
+		}
+		return true
+	}
+	fmt.Fprintf(os.Stderr, "⚠️  %s=%s 不存在，已省略\n", key, value)
+	return false
+}
+
+// shellQuote 转义单引号，保证输出能安全地被 eval
+func shellQuote(s string) string {
+	return strings.ReplaceAll(s, "'", `'\''`)
+}
@@ -0,0 +1,52 @@
+package gogogorc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// formats 支持的输出格式；posix 兼容 mksh/bash/zsh
+var formats = []string{"posix", "fish", "csh", "pwsh", "env"}
+
+// renderExport 按目标 shell 的语法输出一条赋值语句
+func renderExport(w io.Writer, format, key, value string) error {
+	var err error
+	switch format {
+	case "posix":
+		_, err = fmt.Fprintf(w, "export %s='%s'\n", key, shellQuote(value))
+	case "fish":
+		_, err = fmt.Fprintf(w, "set -gx %s '%s'\n", key, shellQuote(value))
+	case "csh":
+		_, err = fmt.Fprintf(w, "setenv %s '%s';\n", key, cshQuote(value))
+	case "pwsh":
+		_, err = fmt.Fprintf(w, "$env:%s = '%s'\n", key, pwshQuote(value))
+	case "env":
+		_, err = fmt.Fprintf(w, "%s=%s\n", key, value)
+	default:
+		return fmt.Errorf("未知格式: %s (支持 %s)", format, strings.Join(formats, "/"))
+	}
+	return err
+}
+
+// renderJSON 以 JSON 对象输出全部变量，供程序消费
+func renderJSON(w io.Writer, resolved []export) error {
+	m := make(map[string]string, len(resolved))
+	for _, e := range resolved {
+		m[e.Key] = e.Value
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(m)
+}
+
+// cshQuote csh 的单引号里无法转义单引号，只能断开再拼接
+func cshQuote(s string) string {
+	return strings.ReplaceAll(s, "'", `'"'"'`)
+}
+
+// pwshQuote PowerShell 单引号字符串里的单引号写成两个
+func pwshQuote(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
@@ -0,0 +1,129 @@
+package gogogorc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pathPolicy PATH 的整理规则。
+// 此前 setupPath 在正式与开发模式下各有一份散落的拷贝，
+// 顺序规则全靠拼接顺序隐含表达；这里把规则收敛成一处：
+//   1. pin 命中的条目提到最前 (按 pin 的声明顺序)
+//   2. 系统目录保持原有顺序
+//   3. 模块的可执行目录跟在系统目录之后 (开发模式提到系统之前)
+//   4. /0/ 下的用户存储路径一律垫底，避免可写目录抢占解析
+//   5. deny 命中的条目直接剔除，全程去重 (先出现者胜)
+type pathPolicy struct {
+	// Pin 提前的子串模式，来自配置 [path] pin
+	Pin []string
+	// Deny 剔除的子串模式，来自配置 [path] deny
+	Deny []string
+}
+
+// denied 条目是否命中剔除模式
+func (pol pathPolicy) denied(entry string) bool {
+	for _, pattern := range pol.Deny {
+		if pattern != "" && strings.Contains(entry, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// pinIndex 条目命中的 pin 模式序号，未命中返回 -1
+func (pol pathPolicy) pinIndex(entry string) int {
+	for i, pattern := range pol.Pin {
+		if pattern != "" && strings.Contains(entry, pattern) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isUserStorage Android 用户存储 (/storage/emulated/0 等挂载点)：
+// 全局可写，放在 PATH 前面等于给任意应用劫持命令的机会
+func isUserStorage(entry string) bool {
+	return strings.Contains(entry, "/0/")
+}
+
+// assemblePath 按策略整理 PATH。base 是进程继承的 PATH，
+// moduleBins 是模块的可执行目录；devFirst 时模块目录压过系统目录
+func assemblePath(base string, moduleBins []string, devFirst bool, pol pathPolicy) string {
+	path, _ := assemblePathExplain(base, moduleBins, devFirst, pol)
+	return path
+}
+
+// assemblePathExplain 同 assemblePath，额外返回每条整理动作的
+// 人类可读说明，供 --explain 诊断"我的命令怎么解析不到了"
+func assemblePathExplain(base string, moduleBins []string, devFirst bool, pol pathPolicy) (string, []string) {
+	baseEntries := splitPath(base)
+	var notes []string
+
+	var pinned, system, user []string
+	for _, entry := range baseEntries {
+		switch {
+		case pol.pinIndex(entry) >= 0:
+			pinned = append(pinned, entry)
+			notes = append(notes, fmt.Sprintf("⬆️  置顶 %s (pin 命中 %q)",
+				entry, pol.Pin[pol.pinIndex(entry)]))
+		case isUserStorage(entry):
+			user = append(user, entry)
+			notes = append(notes, "⬇️  垫底 "+entry+" (用户存储路径)")
+		default:
+			system = append(system, entry)
+		}
+	}
+	for _, bin := range moduleBins {
+		if devFirst {
+			notes = append(notes, "➕ 新增 "+bin+" (模块目录，压过系统目录)")
+		} else {
+			notes = append(notes, "➕ 新增 "+bin+" (模块目录，跟在系统目录后)")
+		}
+	}
+	// pin 命中的条目按模式的声明顺序排，稳定排序保留组内原序
+	for i := 1; i < len(pinned); i++ {
+		for j := i; j > 0 && pol.pinIndex(pinned[j]) < pol.pinIndex(pinned[j-1]); j-- {
+			pinned[j], pinned[j-1] = pinned[j-1], pinned[j]
+		}
+	}
+
+	ordered := append([]string{}, pinned...)
+	if devFirst {
+		ordered = append(ordered, moduleBins...)
+		ordered = append(ordered, system...)
+	} else {
+		ordered = append(ordered, system...)
+		ordered = append(ordered, moduleBins...)
+	}
+	ordered = append(ordered, user...)
+
+	seen := map[string]bool{}
+	var final []string
+	for _, entry := range ordered {
+		if entry == "" {
+			continue
+		}
+		if seen[entry] {
+			notes = append(notes, "🧹 去重 "+entry)
+			continue
+		}
+		if pol.denied(entry) {
+			notes = append(notes, "🚫 剔除 "+entry+" (deny 命中)")
+			continue
+		}
+		seen[entry] = true
+		final = append(final, entry)
+	}
+	return strings.Join(final, ":"), notes
+}
+
+// splitPath 拆分 PATH，丢弃空段
+func splitPath(path string) []string {
+	var entries []string
+	for _, entry := range strings.Split(path, ":") {
+		if entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,39 @@
+package gogogorc
+
+import "testing"
+
+func TestAssemblePathDedupeAndOrder(t *testing.T) {
+	base := "/system/bin:/vendor/bin:/system/bin:/storage/emulated/0/bin"
+	got := assemblePath(base, []string{"/data/adb/modules/gogogo/bin"}, false, pathPolicy{})
+	want := "/system/bin:/vendor/bin:/data/adb/modules/gogogo/bin:/storage/emulated/0/bin"
+	if got != want {
+		t.Errorf("assemblePath = %q, want %q", got, want)
+	}
+}
+
+func TestAssemblePathDevFirst(t *testing.T) {
+	got := assemblePath("/system/bin", []string{"/mod/xbin"}, true, pathPolicy{})
+	if got != "/mod/xbin:/system/bin" {
+		t.Errorf("开发模式未把模块目录提前: %q", got)
+	}
+}
+
+func TestAssemblePathPinDeny(t *testing.T) {
+	base := "/system/bin:/opt/tools/bin:/usr/bin:/blocked/bin"
+	pol := pathPolicy{Pin: []string{"/opt/tools"}, Deny: []string{"/blocked"}}
+	got := assemblePath(base, nil, false, pol)
+	want := "/opt/tools/bin:/system/bin:/usr/bin"
+	if got != want {
+		t.Errorf("assemblePath = %q, want %q", got, want)
+	}
+}
+
+func TestAssemblePathPinOrderFollowsPatterns(t *testing.T) {
+	base := "/a/bin:/b/bin:/c/bin"
+	pol := pathPolicy{Pin: []string{"/c", "/a"}}
+	got := assemblePath(base, nil, false, pol)
+	want := "/c/bin:/a/bin:/b/bin"
+	if got != want {
+		t.Errorf("pin 顺序不对: %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+package gogogorc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// pathProfile 一套具名的模块可执行目录组合。
+// 旧的 gogogo.dev 分支手工拼目录，GOXBIN 被追加了两次而 GOBIN
+// 被整个丢掉；改成声明式 profile 后目录列表只有一处定义
+type pathProfile struct {
+	// Extends 继承的父 profile，本 profile 的目录排在继承来的前面
+	Extends string
+	// Bins 目录模板，支持 ${MODDIR} 与 ${<导出变量>} 占位符
+	Bins []string
+	// Front 目录是否压过系统目录 (开发场景用)
+	Front bool
+	// frontSet front 是否被显式配置，继承时未设置的沿用父值
+	frontSet bool
+}
+
+// builtinProfiles 内置 profile：default 正式使用，dev 调试版本优先
+func builtinProfiles() map[string]pathProfile {
+	return map[string]pathProfile{
+		"default": {
+			Bins:     []string{"${GOROOT}/bin", "${GOBIN}"},
+			frontSet: true,
+		},
+		"dev": {
+			Extends:  "default",
+			Bins:     []string{"${GOXBIN}"},
+			Front:    true,
+			frontSet: true,
+		},
+	}
+}
+
+// profileName 决定本次使用的 profile：
+// --profile > gogogo.dev 文件内容 (非空首行为 profile 名) > default
+func profileName(cfg *rcConfig) string {
+	if flagProfile != "" {
+		return flagProfile
+	}
+	data, err := os.ReadFile(filepath.Join(cfg.ModuleDir, "gogogo.dev"))
+	if err != nil {
+		return "default"
+	}
+	if name, _, _ := strings.Cut(string(data), "\n"); strings.TrimSpace(name) != "" {
+		return strings.TrimSpace(name)
+	}
+	return "dev"
+}
+
+// resolveProfile 沿 extends 链展开 profile。
+// 子 profile 的目录排在继承来的前面；front 未显式配置时沿用父值
+func resolveProfile(cfg *rcConfig, name string) (pathProfile, error) {
+	resolved := pathProfile{}
+	seen := map[string]bool{}
+	for name != "" {
+		if seen[name] {
+			return resolved, fmt.Errorf("profile 继承成环: %s", name)
+		}
+		seen[name] = true
+		p, ok := cfg.Profiles[name]
+		if !ok {
+			p, ok = builtinProfiles()[name]
+		}
+		if !ok {
+			return resolved, fmt.Errorf("未知 profile: %s", name)
+		}
+		resolved.Bins = append(resolved.Bins, p.Bins...)
+		if !resolved.frontSet && p.frontSet {
+			resolved.Front = p.Front
+			resolved.frontSet = true
+		}
+		name = p.Extends
+	}
+	return resolved, nil
+}
+
+// profileBins 展开 profile 的目录模板，丢弃展开后为空的项
+func profileBins(cfg *rcConfig, p pathProfile) []string {
+	var bins []string
+	for _, tmpl := range p.Bins {
+		if dir := cfg.expandRefs(tmpl); dir != "" && !strings.Contains(dir, "${") {
+			bins = append(bins, dir)
+		}
+	}
+	return bins
+}
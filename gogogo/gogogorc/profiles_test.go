@@ -0,0 +1,64 @@
+package gogogorc
+
+import (
+	"strings"
+	"testing"
+)
+
+func testConfig() *rcConfig {
+	return &rcConfig{
+		ModuleDir: "/mod",
+		Exports:   defaultExports(),
+		Profiles:  map[string]pathProfile{},
+	}
+}
+
+func profilePath(t *testing.T, cfg *rcConfig, name string) string {
+	t.Helper()
+	p, err := resolveProfile(cfg, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return assemblePath("/system/bin", profileBins(cfg, p), p.Front, pathPolicy{})
+}
+
+func TestProfileDefault(t *testing.T) {
+	got := profilePath(t, testConfig(), "default")
+	want := "/system/bin:/mod/go/bin:/mod/bin"
+	if got != want {
+		t.Errorf("default profile PATH = %q, want %q", got, want)
+	}
+}
+
+func TestProfileDevKeepsGobinAndXbinOnce(t *testing.T) {
+	got := profilePath(t, testConfig(), "dev")
+	want := "/mod/xbin:/mod/go/bin:/mod/bin:/system/bin"
+	if got != want {
+		t.Errorf("dev profile PATH = %q, want %q", got, want)
+	}
+	if strings.Count(got, "/mod/xbin") != 1 {
+		t.Errorf("GOXBIN 出现多次: %q", got)
+	}
+}
+
+func TestProfileCustomExtends(t *testing.T) {
+	cfg := testConfig()
+	cfg.Profiles["custom"] = pathProfile{
+		Extends: "default",
+		Bins:    []string{"${MODDIR}/tools"},
+	}
+	got := profilePath(t, cfg, "custom")
+	want := "/system/bin:/mod/tools:/mod/go/bin:/mod/bin"
+	if got != want {
+		t.Errorf("custom profile PATH = %q, want %q", got, want)
+	}
+}
+
+func TestProfileExtendCycle(t *testing.T) {
+	cfg := testConfig()
+	cfg.Profiles["a"] = pathProfile{Extends: "b"}
+	cfg.Profiles["b"] = pathProfile{Extends: "a"}
+	if _, err := resolveProfile(cfg, "a"); err == nil {
+		t.Error("继承成环未报错")
+	}
+}
@@ -0,0 +1,89 @@
+// Package gogogorc 生成根模块的环境变量导出语句。
+// 输出写到标准输出供 eval，所有提示与错误一律走标准错误，
+// 避免污染被 eval 的内容。
+package gogogorc
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Version 由 main 包注入
+var Version = "dev"
+
+var (
+	flagConfig    string
+	flagModuleDir string
+	flagFormat    string
+	flagJSON      bool
+	flagProfile   string
+	flagNoCache   bool
+	flagCacheFile string
+	flagExplain   bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "gogogorc",
+	Short: "🐚 输出根模块的环境变量导出语句",
+	Long: `gogogorc 读取 gogogorc.toml (默认在模块目录下)，
+把模块的 GOROOT/GOBIN 等路径以 export 语句输出到标准输出，
+供 shell 启动脚本 eval。没有配置文件时使用内置默认值，
+非标准安装位置改配置即可，无需重新编译。`,
+	SilenceUsage: true,
+	Args:         cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, configPath, err := loadConfig(flagConfig, flagModuleDir)
+		if err != nil {
+			return err
+		}
+		// 开机脚本每次都要跑，输入不变时直接回放缓存，
+		// 把慢设备上的耗时压进 5ms 目标。
+		// --explain 的价值在于展示整理过程，回放缓存会跳过计算，
+		// 因此诊断时强制重算
+		if flagExplain {
+			flagNoCache = true
+		}
+		hash := inputHash(cfg, configPath, flagFormat, flagJSON)
+		if !flagNoCache {
+			if block, ok := readCache(cachePath(cfg), hash); ok {
+				_, err := os.Stdout.WriteString(block)
+				return err
+			}
+		}
+		var buf strings.Builder
+		if err := emit(&buf, cfg, flagFormat, flagJSON); err != nil {
+			return err
+		}
+		if !flagNoCache {
+			if err := writeCache(cachePath(cfg), hash, buf.String()); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  写入缓存失败: %v\n", err)
+			}
+		}
+		_, err = os.Stdout.WriteString(buf.String())
+		return err
+	},
+}
+
+// Execute 运行 gogogorc 命令树
+func Execute() error {
+	rootCmd.Version = Version
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&flagConfig, "config", "", "gogogorc.toml 路径 (默认模块目录下)")
+	rootCmd.PersistentFlags().StringVar(&flagModuleDir, "module-dir", "", "模块目录 (默认 /data/adb/modules/gogogo)")
+	rootCmd.PersistentFlags().StringVar(&flagFormat, "format", "posix", "输出格式: posix/fish/csh/pwsh/env")
+	rootCmd.PersistentFlags().BoolVar(&flagJSON, "json", false, "以 JSON 对象输出，供程序消费")
+	rootCmd.PersistentFlags().StringVar(&flagProfile, "profile", "", "path profile 名 (默认按 gogogo.dev 标记选择)")
+	rootCmd.PersistentFlags().BoolVar(&flagNoCache, "no-cache", false, "绕过缓存，强制重新计算")
+	rootCmd.PersistentFlags().StringVar(&flagCacheFile, "cache-file", "", "缓存文件路径 (默认模块目录 cache/ 下)")
+	rootCmd.PersistentFlags().BoolVar(&flagExplain, "explain", false, "向标准错误输出 PATH 整理过程，便于诊断")
+}
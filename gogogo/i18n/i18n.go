@@ -0,0 +1,132 @@
+// Package i18n 提供按消息 ID 检索的中英双语消息目录，
+// 语言由 --lang 参数或 LANG/LC_ALL 环境变量决定
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+var lang = "zh"
+
+// SetLang 设置输出语言，空串表示根据环境自动检测
+func SetLang(l string) {
+	switch strings.ToLower(l) {
+	case "zh", "zh-cn", "zh_cn":
+		lang = "zh"
+	case "en", "en-us", "en_us":
+		lang = "en"
+	case "":
+		lang = Detect()
+	}
+}
+
+// Lang 返回当前语言
+func Lang() string { return lang }
+
+// Detect 根据 LANG/LC_ALL 环境变量推断语言，默认中文
+func Detect() string {
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		v := strings.ToLower(os.Getenv(env))
+		if v == "" {
+			continue
+		}
+		if strings.HasPrefix(v, "zh") {
+			return "zh"
+		}
+		return "en"
+	}
+	return "zh"
+}
+
+// T 按消息 ID 取当前语言的文案并格式化；未收录的 ID 原样返回
+func T(key string, args ...any) string {
+	m, ok := catalog[key]
+	if !ok {
+		return key
+	}
+	s, ok := m[lang]
+	if !ok {
+		s = m["zh"]
+	}
+	if len(args) == 0 {
+		return s
+	}
+	return fmt.Sprintf(s, args...)
+}
+
+var catalog = map[string]map[string]string{
+	"build.start": {
+		"zh": "🚀 开始构建 %d 个平台 (并行 %d)\n",
+		"en": "🚀 Building %d platforms (parallelism %d)\n",
+	},
+	"build.summary": {
+		"zh": "\n📊 构建完成: 成功 %d / 失败 %d / 总计 %d，耗时 %.1fs\n",
+		"en": "\n📊 Build finished: %d succeeded / %d failed / %d total in %.1fs\n",
+	},
+	"build.file_not_found": {
+		"zh": "文件未找到",
+		"en": "file not found",
+	},
+	"build.failed_count": {
+		"zh": "%d 个平台构建失败",
+		"en": "%d platforms failed to build",
+	},
+	"build.cancelled": {
+		"zh": "fail-fast 已取消",
+		"en": "cancelled by fail-fast",
+	},
+	"build.go_build_failed": {
+		"zh": "go build 失败: %w\n%s",
+		"en": "go build failed: %w\n%s",
+	},
+	"retry.permanent": {
+		"zh": "  🚫 %s 确定性错误，不再重试\n",
+		"en": "  🚫 %s permanent error, not retrying\n",
+	},
+	"retry.retrying": {
+		"zh": "  🔁 %s 第 %d 次尝试失败，%s 后重试\n",
+		"en": "  🔁 %s attempt %d failed, retrying in %s\n",
+	},
+	"ndk.not_found_confirm": {
+		"zh": "未找到 Android NDK，是否以 CGO_ENABLED=0 继续构建?",
+		"en": "Android NDK not found. Continue with CGO_ENABLED=0?",
+	},
+	"ndk.missing": {
+		"zh": "缺少 Android NDK",
+		"en": "Android NDK missing",
+	},
+	"test.failed_count": {
+		"zh": "%d 个平台测试/验证失败",
+		"en": "tests/verification failed on %d platforms",
+	},
+	"platform.invalid": {
+		"zh": "无效的平台格式: %q (应为 os/arch)",
+		"en": "invalid platform format: %q (expected os/arch)",
+	},
+	"platform.variant_unsupported": {
+		"zh": "架构 %[2]s 不支持子架构变体 %[1]q",
+		"en": "architecture %[2]s does not support sub-arch variant %[1]q",
+	},
+	"build.log_hint": {
+		"zh": "完整日志: %s",
+		"en": "full log: %s",
+	},
+	"platform.unsupported": {
+		"zh": "当前工具链不支持以下平台: %s",
+		"en": "unsupported by this toolchain: %s",
+	},
+	"platform.did_you_mean": {
+		"zh": " (是不是想要 %s?)",
+		"en": " (did you mean %s?)",
+	},
+	"platform.empty": {
+		"zh": "未指定任何平台",
+		"en": "no platforms specified",
+	},
+	"platform.group_cycle": {
+		"zh": "平台组循环引用: %s",
+		"en": "platform group cycle: %s",
+	},
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"os"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/commands"
+)
+
+// Version 由 -ldflags "-X main.Version=..." 注入
+var Version = "dev"
+
+func main() {
+	commands.Version = Version
+	if err := commands.Execute(); err != nil {
+		os.Exit(commands.ExitCode(err))
+	}
+}
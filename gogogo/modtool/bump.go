@@ -0,0 +1,140 @@
+package modtool
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	bumpDir          string
+	bumpVersion      string
+	bumpVersionCode  int
+	bumpZipURL       string
+	bumpChangelogURL string
+)
+
+// updateJSON 根模块管理器轮询的 update.json 结构
+type updateJSON struct {
+	Version     string `json:"version"`
+	VersionCode int    `json:"versionCode"`
+	ZipURL      string `json:"zipUrl"`
+	Changelog   string `json:"changelog"`
+}
+
+var bumpCmd = &cobra.Command{
+	Use:   "bump",
+	Short: "⬆️  更新 module.prop 与 update.json 的版本号",
+	Long: `一次完成模块发版的元数据修改：改写 module.prop 的
+version/versionCode，重生成指向新版 zip 的 update.json
+并校验其结构，避免手工编辑出错。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if bumpVersion == "" {
+			return fmt.Errorf("请用 --version 指定新版本号 (如 v1.2.3)")
+		}
+		propPath := filepath.Join(bumpDir, "module.prop")
+		prop, err := loadProp(propPath)
+		if err != nil {
+			return err
+		}
+		oldVersion := prop.get("version")
+		code := bumpVersionCode
+		if code <= 0 {
+			old, err := strconv.Atoi(prop.get("versionCode"))
+			if err != nil {
+				return fmt.Errorf("module.prop 的 versionCode 不是数字: %s", prop.get("versionCode"))
+			}
+			code = old + 1
+		}
+		prop.set("version", bumpVersion)
+		prop.set("versionCode", strconv.Itoa(code))
+		if err := prop.save(propPath); err != nil {
+			return err
+		}
+		fmt.Printf("✅ module.prop: %s → %s (versionCode %d)\n", oldVersion, bumpVersion, code)
+
+		update, err := regenerateUpdateJSON(bumpDir, prop.get("id"), oldVersion, bumpVersion, code)
+		if err != nil {
+			return err
+		}
+		if err := validateUpdateJSON(update); err != nil {
+			return fmt.Errorf("update.json 校验失败: %w", err)
+		}
+		fmt.Printf("✅ update.json → %s\n", update.ZipURL)
+		return nil
+	},
+}
+
+// regenerateUpdateJSON 重写 update.json：已有文件时把 URL 里的旧版本号
+// 替换为新版本号，没有时按 --zip-url/--changelog-url 新建
+func regenerateUpdateJSON(dir, id, oldVersion, version string, code int) (*updateJSON, error) {
+	path := filepath.Join(dir, "update.json")
+	update := &updateJSON{}
+	if data, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(data, update); err != nil {
+			return nil, fmt.Errorf("解析现有 update.json 失败: %w", err)
+		}
+	}
+	update.Version = version
+	update.VersionCode = code
+	switch {
+	case bumpZipURL != "":
+		update.ZipURL = bumpZipURL
+	case update.ZipURL != "" && oldVersion != "":
+		update.ZipURL = strings.ReplaceAll(update.ZipURL, oldVersion, version)
+	default:
+		return nil, fmt.Errorf("update.json 没有 zipUrl，请用 --zip-url 指定 (可含 %s-%s.zip)", id, version)
+	}
+	if bumpChangelogURL != "" {
+		update.Changelog = bumpChangelogURL
+	} else if update.Changelog != "" && oldVersion != "" {
+		update.Changelog = strings.ReplaceAll(update.Changelog, oldVersion, version)
+	}
+	data, err := json.MarshalIndent(update, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+// validateUpdateJSON 校验 update.json 的必需字段与 URL 合法性
+func validateUpdateJSON(u *updateJSON) error {
+	if u.Version == "" {
+		return fmt.Errorf("version 为空")
+	}
+	if u.VersionCode <= 0 {
+		return fmt.Errorf("versionCode 必须是正整数")
+	}
+	for name, raw := range map[string]string{"zipUrl": u.ZipURL, "changelog": u.Changelog} {
+		if raw == "" {
+			if name == "zipUrl" {
+				return fmt.Errorf("zipUrl 为空")
+			}
+			continue
+		}
+		parsed, err := url.Parse(raw)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			return fmt.Errorf("%s 不是合法的 http(s) 地址: %s", name, raw)
+		}
+	}
+	return nil
+}
+
+func init() {
+	bumpCmd.Flags().StringVarP(&bumpDir, "dir", "d", ".", "模块目录 (含 module.prop)")
+	bumpCmd.Flags().StringVar(&bumpVersion, "version", "", "新版本号 (如 v1.2.3)")
+	bumpCmd.Flags().IntVar(&bumpVersionCode, "version-code", 0, "新 versionCode (默认旧值 +1)")
+	bumpCmd.Flags().StringVar(&bumpZipURL, "zip-url", "", "新版 zip 下载地址 (默认在旧地址上替换版本号)")
+	bumpCmd.Flags().StringVar(&bumpChangelogURL, "changelog-url", "", "更新日志地址")
+	rootCmd.AddCommand(bumpCmd)
+}
@@ -0,0 +1,124 @@
+package modtool
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/utils"
+)
+
+// initManagers 支持的根方案目标
+var initManagers = map[string]bool{"magisk": true, "kernelsu": true, "apatch": true}
+
+// initAnswers 脚手架的全部回答
+type initAnswers struct {
+	ID          string
+	Name        string
+	Author      string
+	Description string
+	UpdateJSON  string
+	// Manager 目标根方案: magisk/kernelsu/apatch
+	Manager string
+	// WebUI 是否生成 webroot (KernelSU/APatch 的 WebUI 入口)
+	WebUI bool
+	// GoSource 是否生成 Go 源码骨架与 gogogo.yaml
+	GoSource bool
+}
+
+var initCmd = &cobra.Command{
+	Use:   "init [目录]",
+	Short: "🌱 交互式生成根模块项目骨架",
+	Long: `从内嵌模板搭建一个新的根模块项目：module.prop、
+META-INF 安装器、customize.sh/service.sh、可选的 webroot
+与 Go 源码骨架 (含 gogogo.yaml)。所有问题都可用
+GOGOGO_ANSWER_* 预置，适合脚本化。`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		a := askInitAnswers(args)
+		if !initManagers[a.Manager] {
+			return fmt.Errorf("不支持的根方案: %s (支持 magisk/kernelsu/apatch)", a.Manager)
+		}
+		dir := a.ID
+		if len(args) > 0 {
+			dir = args[0]
+		}
+		if _, err := os.Stat(filepath.Join(dir, "module.prop")); err == nil {
+			return fmt.Errorf("%s 下已有 module.prop，不会覆盖现有模块", dir)
+		}
+		if err := scaffoldModule(dir, a); err != nil {
+			return err
+		}
+		fmt.Printf("✅ 模块骨架已生成: %s (%s)\n", dir, a.Manager)
+		fmt.Println("   下一步: 编辑 src/main.go 后执行 gogogo -s ./src --abi-split，再 modtool pack")
+		return nil
+	},
+}
+
+// askInitAnswers 逐项收集脚手架回答，全部支持预置应答
+func askInitAnswers(args []string) initAnswers {
+	defaultID := "mymodule"
+	if len(args) > 0 {
+		defaultID = filepath.Base(args[0])
+	}
+	a := initAnswers{}
+	a.ID = utils.Input("init.id", "模块 id (小写字母/数字/下划线)", defaultID)
+	a.Name = utils.Input("init.name", "模块名称", a.ID)
+	a.Author = utils.Input("init.author", "作者", os.Getenv("USER"))
+	a.Description = utils.Input("init.description", "一句话描述", a.Name+" 模块")
+	a.Manager = strings.ToLower(utils.Input("init.manager", "目标根方案 (magisk/kernelsu/apatch)", "magisk"))
+	a.UpdateJSON = utils.Input("init.update_json", "updateJson 地址 (留空跳过)", "")
+	a.WebUI = a.Manager != "magisk" && utils.Confirm("init.webui", "生成 WebUI (webroot) 入口吗?")
+	a.GoSource = utils.Confirm("init.go_source", "生成 Go 源码骨架与 gogogo.yaml 吗?")
+	return a
+}
+
+// scaffoldModule 按回答落盘项目骨架
+func scaffoldModule(dir string, a initAnswers) error {
+	files := map[string]string{
+		"module.prop":  renderTemplate("module.prop.tmpl", a),
+		"customize.sh": tmplFile("customize.sh"),
+		"service.sh":   tmplFile("service.sh"),
+		"META-INF/com/google/android/update-binary":  tmplFile("update-binary"),
+		"META-INF/com/google/android/updater-script": tmplFile("updater-script"),
+	}
+	if a.WebUI {
+		files["webroot/index.html"] = renderTemplate("webroot/index.html", a)
+	}
+	if a.GoSource {
+		files["src/main.go"] = renderTemplate("main.go.tmpl", a)
+		files["gogogo.yaml"] = tmplFile("gogogo.yaml")
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		mode := os.FileMode(0o644)
+		if strings.HasSuffix(name, ".sh") || strings.HasSuffix(name, "update-binary") {
+			mode = 0o755
+		}
+		if err := os.WriteFile(path, []byte(content), mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderTemplate 渲染内嵌的 text/template 模板
+func renderTemplate(name string, a initAnswers) string {
+	tmpl := template.Must(template.New(name).Parse(tmplFile(name)))
+	var b strings.Builder
+	if err := tmpl.Execute(&b, a); err != nil {
+		panic("渲染模板 " + name + " 失败: " + err.Error())
+	}
+	return b.String()
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+}
@@ -0,0 +1,176 @@
+package modtool
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	packDir      string
+	packBinaries string
+	packOutput   string
+)
+
+// packSkipDirs 打包时跳过的目录
+var packSkipDirs = map[string]bool{".git": true, "build": true, "node_modules": true}
+
+var packCmd = &cobra.Command{
+	Use:   "pack",
+	Short: "📦 把模块目录装配成可刷入的根模块 zip",
+	Long: `打包模块目录为 Magisk/KernelSU/APatch 可刷入的 zip：
+收入 module.prop 与全部模块文件，补齐缺失的 META-INF 安装器、
+customize.sh 与 service.sh 模板，--binaries 时并入 gogogo
+--abi-split 产出的 lib/<abi>/ 二进制。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		prop, err := loadProp(filepath.Join(packDir, "module.prop"))
+		if err != nil {
+			return fmt.Errorf("读取 module.prop 失败: %w", err)
+		}
+		output := packOutput
+		if output == "" {
+			output = fmt.Sprintf("%s-%s.zip", prop.get("id"), prop.get("version"))
+		}
+		if err := packModule(packDir, packBinaries, output); err != nil {
+			return err
+		}
+		info, err := os.Stat(output)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ %s (%s %s, %.2f MB)\n",
+			output, prop.get("id"), prop.get("version"), float64(info.Size())/1024/1024)
+		return nil
+	},
+}
+
+// packModule 把模块目录 (及可选的二进制目录) 写成 zip
+func packModule(dir, binaries, output string) error {
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	zw := zip.NewWriter(f)
+
+	written := map[string]bool{}
+	absOutput, _ := filepath.Abs(output)
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if packSkipDirs[d.Name()] && rel != "." {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if abs, _ := filepath.Abs(path); abs == absOutput {
+			return nil
+		}
+		name := filepath.ToSlash(rel)
+		written[name] = true
+		return addZipFile(zw, name, path)
+	})
+	if err != nil {
+		zw.Close()
+		return err
+	}
+
+	if binaries != "" {
+		if err := addBinaries(zw, binaries, written); err != nil {
+			zw.Close()
+			return err
+		}
+	}
+
+	// 补齐缺失的安装器与脚本，保证 zip 可直接刷入
+	defaults := map[string]string{
+		"META-INF/com/google/android/update-binary":  tmplFile("update-binary"),
+		"META-INF/com/google/android/updater-script": tmplFile("updater-script"),
+		"customize.sh": tmplFile("customize.sh"),
+		"service.sh":   tmplFile("service.sh"),
+	}
+	for name, content := range defaults {
+		if written[name] {
+			continue
+		}
+		w, err := zw.Create(name)
+		if err != nil {
+			zw.Close()
+			return err
+		}
+		if _, err := io.WriteString(w, content); err != nil {
+			zw.Close()
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// addBinaries 把 gogogo --abi-split 的 lib/<abi>/ 布局并入 zip
+func addBinaries(zw *zip.Writer, binaries string, written map[string]bool) error {
+	libDir := filepath.Join(binaries, "lib")
+	if _, err := os.Stat(libDir); err != nil {
+		return fmt.Errorf("%s 下没有 lib/<abi>/ 布局，请先用 gogogo --abi-split 构建", binaries)
+	}
+	return filepath.WalkDir(libDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(binaries, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if written[name] {
+			return nil
+		}
+		written[name] = true
+		return addZipFile(zw, name, path)
+	})
+}
+
+// addZipFile 以 deflate 压缩把单个文件写进 zip，保留可执行权限
+func addZipFile(zw *zip.Writer, name, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	header := &zip.FileHeader{Name: name, Method: zip.Deflate}
+	header.SetMode(info.Mode().Perm())
+	// 脚本与二进制统一给执行位，zip 内权限在设备上不可靠但聊胜于无
+	if strings.HasSuffix(name, ".sh") || strings.HasPrefix(name, "lib/") ||
+		strings.HasSuffix(name, "update-binary") {
+		header.SetMode(0o755)
+	}
+	w, err := zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+func init() {
+	packCmd.Flags().StringVarP(&packDir, "dir", "d", ".", "模块目录 (含 module.prop)")
+	packCmd.Flags().StringVar(&packBinaries, "binaries", "", "gogogo 构建输出目录，并入其中的 lib/<abi>/ 二进制")
+	packCmd.Flags().StringVarP(&packOutput, "output", "o", "", "输出 zip 路径 (默认 <id>-<version>.zip)")
+	rootCmd.AddCommand(packCmd)
+}
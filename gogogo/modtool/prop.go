@@ -0,0 +1,65 @@
+package modtool
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// moduleProp module.prop 的键值内容，保留原始行序便于回写
+type moduleProp struct {
+	// keys 原始键序
+	keys []string
+	// values 键值表
+	values map[string]string
+}
+
+// loadProp 读取并解析 module.prop (key=value，# 注释)
+func loadProp(path string) (*moduleProp, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &moduleProp{values: map[string]string{}}
+	for i, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: 不是 key=value 格式: %s", path, i+1, trimmed)
+		}
+		key = strings.TrimSpace(key)
+		if _, dup := p.values[key]; !dup {
+			p.keys = append(p.keys, key)
+		}
+		p.values[key] = strings.TrimSpace(value)
+	}
+	for _, required := range []string{"id", "version", "versionCode"} {
+		if p.values[required] == "" {
+			return nil, fmt.Errorf("%s: 缺少必需字段 %s", path, required)
+		}
+	}
+	return p, nil
+}
+
+// get 读取字段值，缺失返回空串
+func (p *moduleProp) get(key string) string { return p.values[key] }
+
+// set 更新字段值，新键追加到末尾
+func (p *moduleProp) set(key, value string) {
+	if _, ok := p.values[key]; !ok {
+		p.keys = append(p.keys, key)
+	}
+	p.values[key] = value
+}
+
+// save 按原始键序写回 module.prop
+func (p *moduleProp) save(path string) error {
+	var b strings.Builder
+	for _, key := range p.keys {
+		fmt.Fprintf(&b, "%s=%s\n", key, p.values[key])
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
@@ -0,0 +1,197 @@
+package modtool
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	publishUpstream string
+	publishZip      string
+	publishTag      string
+	publishDir      string
+	publishSkip     bool
+)
+
+// publishClient 发布用的 HTTP 客户端，上传大 zip 给足超时
+var publishClient = &http.Client{Timeout: 5 * time.Minute}
+
+var publishCmd = &cobra.Command{
+	Use:   "publish",
+	Short: "🚀 把打包好的模块 zip 与元数据发布到上游仓库",
+	Long: `把模块 zip 与 update.json 作为 Release 资产推送到
+--upstream 指定的 GitHub 仓库。认证使用环境变量
+UPSTREAM_ACCESS_TOKEN；没有令牌时给出明确指引，
+--skip-upstream 可在本地流水线里跳过这一步。`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		token := os.Getenv("UPSTREAM_ACCESS_TOKEN")
+		if token == "" {
+			if publishSkip {
+				fmt.Println("⏭️  未设置 UPSTREAM_ACCESS_TOKEN，按 --skip-upstream 跳过上游发布")
+				return nil
+			}
+			return fmt.Errorf(`未设置 UPSTREAM_ACCESS_TOKEN，无法发布到上游仓库。
+解决方法:
+  1. 在 GitHub → Settings → Developer settings 创建对 %s 有 contents:write 权限的令牌
+  2. export UPSTREAM_ACCESS_TOKEN=<令牌> 后重试
+  3. 或加 --skip-upstream 跳过上游发布`, publishUpstream)
+		}
+		owner, repo, ok := strings.Cut(publishUpstream, "/")
+		if !ok || owner == "" || repo == "" {
+			return fmt.Errorf("--upstream 需要 owner/repo 格式，得到 %q", publishUpstream)
+		}
+
+		prop, err := loadProp(filepath.Join(publishDir, "module.prop"))
+		if err != nil {
+			return err
+		}
+		zipPath := publishZip
+		if zipPath == "" {
+			zipPath = filepath.Join(publishDir, fmt.Sprintf("%s-%s.zip", prop.get("id"), prop.get("version")))
+		}
+		if _, err := os.Stat(zipPath); err != nil {
+			return fmt.Errorf("找不到模块 zip %s，请先 modtool pack", zipPath)
+		}
+		tag := publishTag
+		if tag == "" {
+			tag = prop.get("version")
+		}
+
+		releaseID, err := ensureRelease(owner, repo, tag, token)
+		if err != nil {
+			return err
+		}
+		if err := uploadAsset(owner, repo, releaseID, zipPath, token); err != nil {
+			return err
+		}
+		updatePath := filepath.Join(publishDir, "update.json")
+		if _, err := os.Stat(updatePath); err == nil {
+			if err := uploadAsset(owner, repo, releaseID, updatePath, token); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("✅ 已发布 %s 到 %s (Release %s)\n", filepath.Base(zipPath), publishUpstream, tag)
+		return nil
+	},
+}
+
+// ensureRelease 返回 tag 对应的 Release ID，不存在则创建
+func ensureRelease(owner, repo, tag, token string) (int64, error) {
+	api := fmt.Sprintf("https://api.github.com/repos/%s/%s/releases", owner, repo)
+	var release struct {
+		ID int64 `json:"id"`
+	}
+	status, err := githubJSON("GET", api+"/tags/"+url.PathEscape(tag), token, nil, &release)
+	if err != nil {
+		return 0, err
+	}
+	if status == http.StatusOK {
+		return release.ID, nil
+	}
+	body, _ := json.Marshal(map[string]any{"tag_name": tag, "name": tag})
+	status, err = githubJSON("POST", api, token, body, &release)
+	if err != nil {
+		return 0, err
+	}
+	if status != http.StatusCreated {
+		return 0, fmt.Errorf("创建 Release %s 失败 (HTTP %d)，请检查令牌权限", tag, status)
+	}
+	return release.ID, nil
+}
+
+// uploadAsset 上传单个文件为 Release 资产，同名旧资产先删除
+func uploadAsset(owner, repo string, releaseID int64, path, token string) error {
+	name := filepath.Base(path)
+	deleteExistingAsset(owner, repo, releaseID, name, token)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	uploadURL := fmt.Sprintf("https://uploads.github.com/repos/%s/%s/releases/%d/assets?name=%s",
+		owner, repo, releaseID, url.QueryEscape(name))
+	req, err := http.NewRequest("POST", uploadURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := publishClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("上传 %s 失败: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("上传 %s 失败 (HTTP %d): %s", name, resp.StatusCode, msg)
+	}
+	fmt.Printf("📤 %s\n", name)
+	return nil
+}
+
+// deleteExistingAsset 删除同名旧资产 (重新发布同一版本时)，失败不致命
+func deleteExistingAsset(owner, repo string, releaseID int64, name, token string) {
+	api := fmt.Sprintf("https://api.github.com/repos/%s/%s/releases/%d/assets", owner, repo, releaseID)
+	var assets []struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+	if status, err := githubJSON("GET", api, token, nil, &assets); err != nil || status != http.StatusOK {
+		return
+	}
+	for _, a := range assets {
+		if a.Name == name {
+			url := fmt.Sprintf("https://api.github.com/repos/%s/%s/releases/assets/%d", owner, repo, a.ID)
+			_, _ = githubJSON("DELETE", url, token, nil, nil)
+		}
+	}
+}
+
+// githubJSON 调用 GitHub API，out 非 nil 时解析响应 JSON
+func githubJSON(method, url, token string, body []byte, out any) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := publishClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("访问 GitHub API 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if out != nil && resp.StatusCode < 300 {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.StatusCode, fmt.Errorf("解析 GitHub API 响应失败: %w", err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+func init() {
+	publishCmd.Flags().StringVar(&publishUpstream, "upstream", "", "上游仓库 (owner/repo)")
+	publishCmd.Flags().StringVar(&publishZip, "zip", "", "模块 zip 路径 (默认 <id>-<version>.zip)")
+	publishCmd.Flags().StringVar(&publishTag, "tag", "", "Release 标签 (默认 module.prop 的 version)")
+	publishCmd.Flags().StringVarP(&publishDir, "dir", "d", ".", "模块目录 (含 module.prop)")
+	publishCmd.Flags().BoolVar(&publishSkip, "skip-upstream", false, "没有令牌时跳过上游发布而不是报错")
+	_ = publishCmd.MarkFlagRequired("upstream")
+	rootCmd.AddCommand(publishCmd)
+}
@@ -0,0 +1,29 @@
+// Package modtool 实现根模块的打包、版本管理与发布命令。
+package modtool
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// Version 由 main 包注入
+var Version = "dev"
+
+var rootCmd = &cobra.Command{
+	Use:   "modtool",
+	Short: "🧩 根模块 (Magisk/KernelSU/APatch) 打包与发布工具",
+	Long: `modtool 把 gogogo 的构建产物装配成可刷入的根模块 zip，
+并负责 module.prop/update.json 的版本维护与上游发布。`,
+	SilenceUsage: true,
+}
+
+// Execute 运行 modtool 命令树
+func Execute() error {
+	rootCmd.Version = Version
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return err
+	}
+	return nil
+}
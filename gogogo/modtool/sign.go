@@ -0,0 +1,262 @@
+package modtool
+
+import (
+	"archive/zip"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// zip 内嵌的校验清单与签名条目
+const (
+	checksumEntry  = "META-INF/gogogo/checksums.txt"
+	signatureEntry = "META-INF/gogogo/checksums.sig"
+)
+
+var (
+	signKeyFile   string
+	verifyPubkey  string
+	keygenOutFile string
+)
+
+var keygenCmd = &cobra.Command{
+	Use:   "keygen",
+	Short: "🔑 生成模块签名密钥对 (ed25519)",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return err
+		}
+		privB64 := base64.StdEncoding.EncodeToString(priv.Seed())
+		if err := os.WriteFile(keygenOutFile, []byte(privB64+"\n"), 0o600); err != nil {
+			return err
+		}
+		pubFile := keygenOutFile + ".pub"
+		pubB64 := base64.StdEncoding.EncodeToString(pub)
+		if err := os.WriteFile(pubFile, []byte(pubB64+"\n"), 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("✅ 私钥: %s (妥善保管)\n", keygenOutFile)
+		fmt.Printf("✅ 公钥: %s (随模块发布)\n", pubFile)
+		return nil
+	},
+}
+
+var signCmd = &cobra.Command{
+	Use:   "sign <zip>",
+	Short: "✍️  为模块 zip 嵌入校验清单与签名",
+	Long: `计算 zip 内每个文件的 SHA-256 写入 ` + checksumEntry + `，
+再用 ed25519 私钥对清单签名嵌入 ` + signatureEntry + `。
+公钥单独发布，用户用 modtool verify 校验。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		priv, err := loadPrivateKey(signKeyFile)
+		if err != nil {
+			return err
+		}
+		if err := signZip(args[0], priv); err != nil {
+			return err
+		}
+		fmt.Printf("✅ 已签名 %s\n", args[0])
+		return nil
+	},
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify <zip>",
+	Short: "🔍 校验模块 zip 的完整性与签名",
+	Long: `重新计算 zip 内所有文件的 SHA-256 与嵌入的清单比对；
+提供 --pubkey 时再校验清单的 ed25519 签名，
+确认 zip 确实出自发布者之手。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return VerifyZip(args[0], verifyPubkey)
+	},
+}
+
+// loadPrivateKey 读取 base64 编码的 ed25519 种子
+func loadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	if path == "" {
+		return nil, fmt.Errorf("请用 --key 指定私钥文件 (modtool keygen 生成)")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("%s 不是合法的 ed25519 私钥文件", path)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// zipChecksums 计算 zip 内所有条目 (除清单与签名本身) 的 SHA-256，
+// 返回 "哈希  路径" 行的排序清单
+func zipChecksums(r *zip.Reader) (string, error) {
+	var lines []string
+	for _, f := range r.File {
+		if f.Name == checksumEntry || f.Name == signatureEntry ||
+			strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, rc)
+		rc.Close()
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, fmt.Sprintf("%s  %s", hex.EncodeToString(h.Sum(nil)), f.Name))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// signZip 重写 zip：保留原有条目，嵌入新的清单与签名
+func signZip(path string, priv ed25519.PrivateKey) error {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	manifest, err := zipChecksums(&zr.Reader)
+	if err != nil {
+		zr.Close()
+		return err
+	}
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(manifest)))
+
+	tmp := path + ".signing"
+	out, err := os.Create(tmp)
+	if err != nil {
+		zr.Close()
+		return err
+	}
+	zw := zip.NewWriter(out)
+	copyErr := func() error {
+		for _, f := range zr.File {
+			if f.Name == checksumEntry || f.Name == signatureEntry {
+				continue
+			}
+			header := f.FileHeader
+			w, err := zw.CreateHeader(&header)
+			if err != nil {
+				return err
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(w, rc)
+			rc.Close()
+			if err != nil {
+				return err
+			}
+		}
+		for name, content := range map[string]string{
+			checksumEntry:  manifest,
+			signatureEntry: sig + "\n",
+		} {
+			w, err := zw.Create(name)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(w, content); err != nil {
+				return err
+			}
+		}
+		return zw.Close()
+	}()
+	zr.Close()
+	out.Close()
+	if copyErr != nil {
+		os.Remove(tmp)
+		return copyErr
+	}
+	return os.Rename(tmp, path)
+}
+
+// VerifyZip 校验 zip 完整性，pubkeyFile 非空时再验签名。
+// 导出给 gogogo self-update 在安装前复用同一套校验
+func VerifyZip(path, pubkeyFile string) error {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	embedded, err := readZipEntry(&zr.Reader, checksumEntry)
+	if err != nil {
+		return fmt.Errorf("zip 里没有校验清单 (%s)，无法校验: %w", checksumEntry, err)
+	}
+	actual, err := zipChecksums(&zr.Reader)
+	if err != nil {
+		return err
+	}
+	if actual != embedded {
+		return fmt.Errorf("❌ 校验失败: zip 内容与嵌入的清单不一致，文件可能被篡改")
+	}
+	fmt.Println("✅ 完整性校验通过: 所有文件与清单一致")
+
+	if pubkeyFile == "" {
+		fmt.Println("⚠️  未提供 --pubkey，跳过签名校验 (无法确认发布者)")
+		return nil
+	}
+	pubData, err := os.ReadFile(pubkeyFile)
+	if err != nil {
+		return err
+	}
+	pub, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(pubData)))
+	if err != nil || len(pub) != ed25519.PublicKeySize {
+		return fmt.Errorf("%s 不是合法的 ed25519 公钥文件", pubkeyFile)
+	}
+	sigB64, err := readZipEntry(&zr.Reader, signatureEntry)
+	if err != nil {
+		return fmt.Errorf("zip 里没有签名 (%s): %w", signatureEntry, err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(sigB64))
+	if err != nil {
+		return fmt.Errorf("签名条目损坏: %w", err)
+	}
+	if !ed25519.Verify(ed25519.PublicKey(pub), []byte(embedded), sig) {
+		return fmt.Errorf("❌ 签名校验失败: 清单不是该公钥对应的私钥签发的")
+	}
+	fmt.Println("✅ 签名校验通过: 发布者身份确认")
+	return nil
+}
+
+// readZipEntry 读取 zip 内单个条目的全部内容
+func readZipEntry(r *zip.Reader, name string) (string, error) {
+	for _, f := range r.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		return string(data), err
+	}
+	return "", fmt.Errorf("条目不存在")
+}
+
+func init() {
+	keygenCmd.Flags().StringVarP(&keygenOutFile, "out", "o", "modtool.key", "私钥输出路径 (公钥为 <路径>.pub)")
+	signCmd.Flags().StringVar(&signKeyFile, "key", "", "ed25519 私钥文件")
+	verifyCmd.Flags().StringVar(&verifyPubkey, "pubkey", "", "发布者的 ed25519 公钥文件")
+	rootCmd.AddCommand(keygenCmd, signCmd, verifyCmd)
+}
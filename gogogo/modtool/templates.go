@@ -0,0 +1,18 @@
+package modtool
+
+import "embed"
+
+// templatesFS 内嵌的模块模板：pack 用它补齐缺失的安装脚本，
+// init 用它生成整个项目骨架
+//
+//go:embed templates
+var templatesFS embed.FS
+
+// tmplFile 读取内嵌模板，模板随二进制编译，缺失属于程序错误
+func tmplFile(name string) string {
+	data, err := templatesFS.ReadFile("templates/" + name)
+	if err != nil {
+		panic("内嵌模板缺失: " + name)
+	}
+	return string(data)
+}
@@ -0,0 +1,11 @@
+#!/system/bin/sh
+# 按设备 ABI 保留对应二进制，其余删除
+ABI=$(getprop ro.product.cpu.abi)
+ui_print "- 设备 ABI: $ABI"
+if [ -d "$MODPATH/lib" ]; then
+  for dir in "$MODPATH"/lib/*; do
+    [ "$(basename "$dir")" = "$ABI" ] || rm -rf "$dir"
+  done
+fi
+set_perm_recursive "$MODPATH" 0 0 0755 0644
+set_perm_recursive "$MODPATH/lib" 0 0 0755 0755 2>/dev/null
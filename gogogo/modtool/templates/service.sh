@@ -0,0 +1,7 @@
+#!/system/bin/sh
+MODDIR=${0%/*}
+# 等待开机完成
+until [ "$(getprop sys.boot_completed)" = "1" ]; do
+  sleep 2
+done
+# 在此启动模块自带的服务
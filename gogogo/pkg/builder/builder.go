@@ -0,0 +1,118 @@
+// Package builder 是 gogogo 构建引擎的稳定嵌入 API，
+// 与 cobra/stdin 完全解耦，供本仓库其他 Go 程序
+// (模块打包器、WebUI 等) 直接调用。
+package builder
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/gobuild"
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/platform"
+)
+
+// BuildRequest 一次构建的完整输入
+type BuildRequest struct {
+	// Source 主包路径，默认 "."
+	Source string
+	// Name 产物名
+	Name string
+	// OutputDir 输出目录，默认 "build"
+	OutputDir string
+	// Platforms 平台或平台组表达式，如 "linux/amd64,android"
+	Platforms string
+	Ldflags   string
+	Tags      string
+	// Parallel 并行度，<=0 取 NumCPU
+	Parallel int
+	// Retries 临时性错误的重试次数
+	Retries int
+	CGO     bool
+}
+
+// TargetResult 单目标结果
+type TargetResult struct {
+	Platform   string
+	OutputPath string
+	Duration   time.Duration
+	Skipped    bool
+	SkipReason string
+	Err        error
+}
+
+// Report 构建汇总
+type Report struct {
+	Targets  []TargetResult
+	Duration time.Duration
+}
+
+// Succeeded 成功目标数
+func (r *Report) Succeeded() int {
+	n := 0
+	for _, t := range r.Targets {
+		if t.Err == nil && !t.Skipped {
+			n++
+		}
+	}
+	return n
+}
+
+// Build 解析请求并执行构建矩阵。
+// 调用方通过 ctx 控制取消（仅在尚未开始时生效）；
+// 构建过程不会读取 stdin。
+func Build(ctx context.Context, req BuildRequest) (*Report, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	platforms, err := platform.Parse(req.Platforms)
+	if err != nil {
+		return nil, err
+	}
+	if req.Source == "" {
+		req.Source = "."
+	}
+	if req.OutputDir == "" {
+		req.OutputDir = "build"
+	}
+	if req.Name == "" {
+		req.Name = "app"
+	}
+	if req.Parallel <= 0 {
+		req.Parallel = runtime.NumCPU()
+	}
+
+	cfg := &gobuild.Config{
+		Source:         req.Source,
+		OutputDir:      req.OutputDir,
+		Name:           req.Name,
+		Platforms:      platforms,
+		Ldflags:        req.Ldflags,
+		Tags:           req.Tags,
+		Parallel:       req.Parallel,
+		CGO:            req.CGO,
+		NonInteractive: true,
+		Retry: gobuild.RetryPolicy{
+			MaxAttempts: req.Retries + 1,
+			Backoff:     gobuild.DefaultRetryPolicy.Backoff,
+			MaxBackoff:  gobuild.DefaultRetryPolicy.MaxBackoff,
+		},
+	}
+	inner, buildErr := gobuild.BuildWithProgress(cfg)
+	if inner == nil {
+		return nil, buildErr
+	}
+
+	report := &Report{Duration: inner.Duration}
+	for _, res := range inner.Results {
+		report.Targets = append(report.Targets, TargetResult{
+			Platform:   res.Platform.String(),
+			OutputPath: res.OutputPath,
+			Duration:   res.Duration,
+			Skipped:    res.Skipped,
+			SkipReason: res.SkipReason,
+			Err:        res.Err,
+		})
+	}
+	return report, buildErr
+}
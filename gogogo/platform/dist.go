@@ -0,0 +1,98 @@
+package platform
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/i18n"
+)
+
+var (
+	distOnce    sync.Once
+	distTargets map[string]bool
+)
+
+// distList 返回当前工具链支持的 os/arch 集合 (go tool dist list)，
+// 命令不可用时返回 nil，调用方应跳过校验
+func distList() map[string]bool {
+	distOnce.Do(func() {
+		out, err := exec.Command("go", "tool", "dist", "list").Output()
+		if err != nil {
+			return
+		}
+		distTargets = make(map[string]bool)
+		for _, line := range strings.Fields(string(out)) {
+			distTargets[line] = true
+		}
+	})
+	return distTargets
+}
+
+// Validate 校验平台列表是否都被工具链支持 (忽略变体)，
+// 对拼写错误给出最接近的合法目标建议。
+// 使用非标准工具链时可通过 --skip-validate 跳过本检查。
+func Validate(ps []Platform) error {
+	targets := distList()
+	if targets == nil {
+		return nil
+	}
+	var invalid []string
+	for _, p := range ps {
+		pair := p.OS + "/" + p.Arch
+		if targets[pair] {
+			continue
+		}
+		if hint := closestTarget(pair, targets); hint != "" {
+			pair += i18n.T("platform.did_you_mean", hint)
+		}
+		invalid = append(invalid, pair)
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf(i18n.T("platform.unsupported"), strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// closestTarget 返回编辑距离最近的合法目标，距离过大时返回空串
+func closestTarget(pair string, targets map[string]bool) string {
+	best, bestDist := "", 4
+	for t := range targets {
+		if d := editDistance(pair, t); d < bestDist {
+			best, bestDist = t, d
+		}
+	}
+	return best
+}
+
+// editDistance 计算两个短字符串的 Levenshtein 距离
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
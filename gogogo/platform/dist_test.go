@@ -0,0 +1,17 @@
+package platform
+
+import "testing"
+
+func TestClosestTarget(t *testing.T) {
+	targets := map[string]bool{
+		"linux/amd64":   true,
+		"linux/arm64":   true,
+		"windows/amd64": true,
+	}
+	if got := closestTarget("linux/amd46", targets); got != "linux/amd64" {
+		t.Errorf("closestTarget = %q", got)
+	}
+	if got := closestTarget("plan9/sparc", targets); got != "" {
+		t.Errorf("距离过大应返回空串, got %q", got)
+	}
+}
@@ -0,0 +1,85 @@
+package platform
+
+// GroupInfo 平台组的描述元数据，供 groups info / groups search 使用
+type GroupInfo struct {
+	// Description 一句话用途说明
+	Description string
+	// Category 大类: 桌面/服务器/移动/容器/打包/其他
+	Category string
+	// Tags 检索标签
+	Tags []string
+}
+
+// GroupMeta 预定义组的元数据，键与 PlatformGroups 一致
+var GroupMeta = map[string]GroupInfo{
+	"desktop": {
+		Description: "三大桌面系统的主流架构",
+		Category:    "桌面",
+		Tags:        []string{"desktop", "windows", "macos", "linux", "gui"},
+	},
+	"server": {
+		Description: "常见服务器部署目标，含主流 BSD",
+		Category:    "服务器",
+		Tags:        []string{"server", "linux", "bsd", "deploy"},
+	},
+	"mobile": {
+		Description: "Android 与 iOS 全部架构",
+		Category:    "移动",
+		Tags:        []string{"mobile", "android", "ios"},
+	},
+	"android": {
+		Description: "Android 设备的四种 ABI",
+		Category:    "移动",
+		Tags:        []string{"mobile", "android", "magisk", "abi"},
+	},
+	"apple": {
+		Description: "Apple 全家桶: macOS 与 iOS",
+		Category:    "移动",
+		Tags:        []string{"apple", "macos", "ios", "darwin"},
+	},
+	"web": {
+		Description: "浏览器与 WASI 的 WebAssembly 目标",
+		Category:    "其他",
+		Tags:        []string{"web", "wasm", "wasi", "browser"},
+	},
+	"embedded": {
+		Description: "嵌入式/路由器常见的 ARM 与 MIPS 目标",
+		Category:    "其他",
+		Tags:        []string{"embedded", "arm", "mips", "riscv", "router"},
+	},
+	"multi-arch": {
+		Description: "Linux 多架构全集，适合发布镜像仓库",
+		Category:    "服务器",
+		Tags:        []string{"linux", "multi-arch", "release"},
+	},
+	"docker": {
+		Description: "docker buildx 常用的容器宿主架构",
+		Category:    "容器",
+		Tags:        []string{"docker", "container", "buildx"},
+	},
+	"container": {
+		Description: "最小容器目标集: linux amd64/arm64",
+		Category:    "容器",
+		Tags:        []string{"docker", "container", "k8s"},
+	},
+	"deb": {
+		Description: "Debian/Ubuntu 软件仓库覆盖的架构",
+		Category:    "打包",
+		Tags:        []string{"deb", "debian", "ubuntu", "package"},
+	},
+	"rpm": {
+		Description: "RHEL/Fedora 软件仓库覆盖的架构",
+		Category:    "打包",
+		Tags:        []string{"rpm", "fedora", "rhel", "package"},
+	},
+	"bsd": {
+		Description: "BSD 系全集",
+		Category:    "服务器",
+		Tags:        []string{"bsd", "freebsd", "openbsd", "netbsd"},
+	},
+	"all": {
+		Description: "工具链支持的全部常用目标",
+		Category:    "其他",
+		Tags:        []string{"all", "everything", "matrix"},
+	},
+}
@@ -0,0 +1,231 @@
+package platform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LIghtJUNction/RootManage-Module-Model/gogogo/i18n"
+)
+
+// Platform 表示一个 GOOS/GOARCH 编译目标，
+// 可带子架构变体 (如 linux/arm/v7、linux/amd64/v3、linux/mips/softfloat)
+type Platform struct {
+	OS   string
+	Arch string
+	// Variant 子架构变体，通过 GOARM/GOAMD64/GOMIPS 等环境变量生效
+	Variant string
+}
+
+// String 返回 "os/arch[/variant]" 形式
+func (p Platform) String() string {
+	s := p.OS + "/" + p.Arch
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+	return s
+}
+
+// ArchLabel 返回用于输出目录/产物命名的架构标识，变体以 - 连接
+func (p Platform) ArchLabel() string {
+	if p.Variant == "" {
+		return p.Arch
+	}
+	return p.Arch + "-" + p.Variant
+}
+
+// VariantEnv 返回变体对应的环境变量键值对，无变体或架构不支持时返回空键
+func (p Platform) VariantEnv() (key, value string) {
+	if p.Variant == "" {
+		return "", ""
+	}
+	switch p.Arch {
+	case "arm":
+		return "GOARM", strings.TrimPrefix(p.Variant, "v")
+	case "amd64":
+		return "GOAMD64", p.Variant
+	case "mips", "mipsle":
+		return "GOMIPS", p.Variant
+	case "mips64", "mips64le":
+		return "GOMIPS64", p.Variant
+	case "riscv64":
+		return "GORISCV64", p.Variant
+	case "ppc64", "ppc64le":
+		return "GOPPC64", p.Variant
+	}
+	return "", ""
+}
+
+// Ext 返回该平台可执行文件的扩展名
+func (p Platform) Ext() string {
+	switch p.OS {
+	case "windows":
+		return ".exe"
+	case "js", "wasip1":
+		return ".wasm"
+	}
+	return ""
+}
+
+// PlatformGroups 预定义平台组，组名可直接用于 -p 参数
+var PlatformGroups = map[string][]string{
+	"desktop": {
+		"windows/amd64", "windows/arm64", "windows/386",
+		"darwin/amd64", "darwin/arm64",
+		"linux/amd64", "linux/arm64", "linux/386",
+	},
+	"server": {
+		"linux/amd64", "linux/arm64",
+		"freebsd/amd64", "openbsd/amd64", "netbsd/amd64",
+	},
+	"mobile": {
+		"android/arm64", "android/arm", "android/amd64",
+		"ios/arm64", "ios/amd64",
+	},
+	"android": {
+		"android/arm64", "android/arm", "android/amd64", "android/386",
+	},
+	"apple": {
+		"darwin/amd64", "darwin/arm64", "ios/arm64", "ios/amd64",
+	},
+	"web": {
+		"js/wasm", "wasip1/wasm",
+	},
+	"embedded": {
+		"linux/arm", "linux/arm64", "linux/mips", "linux/mipsle",
+		"linux/mips64", "linux/mips64le", "linux/riscv64",
+	},
+	"multi-arch": {
+		"linux/amd64", "linux/arm64", "linux/arm", "linux/386",
+		"linux/ppc64le", "linux/s390x", "linux/riscv64",
+	},
+	// docker/container 组：常见容器宿主架构
+	"docker": {
+		"linux/amd64", "linux/arm64", "linux/arm",
+	},
+	"container": {
+		"linux/amd64", "linux/arm64",
+	},
+	"deb": {
+		"linux/amd64", "linux/arm64", "linux/arm", "linux/386",
+	},
+	"rpm": {
+		"linux/amd64", "linux/arm64", "linux/ppc64le", "linux/s390x",
+	},
+	"bsd": {
+		"freebsd/amd64", "freebsd/arm64", "openbsd/amd64", "netbsd/amd64", "dragonfly/amd64",
+	},
+	"all": {
+		"windows/amd64", "windows/arm64", "windows/386", "windows/arm",
+		"darwin/amd64", "darwin/arm64",
+		"linux/amd64", "linux/arm64", "linux/arm", "linux/386",
+		"linux/mips", "linux/mipsle", "linux/mips64", "linux/mips64le",
+		"linux/ppc64", "linux/ppc64le", "linux/riscv64", "linux/s390x", "linux/loong64",
+		"android/arm64", "android/arm", "android/amd64", "android/386",
+		"ios/arm64", "ios/amd64",
+		"freebsd/amd64", "freebsd/arm64", "freebsd/386", "freebsd/arm",
+		"openbsd/amd64", "openbsd/arm64", "netbsd/amd64", "dragonfly/amd64",
+		"solaris/amd64", "illumos/amd64", "aix/ppc64",
+		"plan9/amd64", "plan9/386",
+		"js/wasm", "wasip1/wasm",
+	},
+}
+
+// customGroups 用户自定义平台组 (gogogo groups add)，
+// 解析时优先于预定义组，允许覆盖同名预定义组
+var customGroups = map[string][]string{}
+
+// RegisterGroup 注册自定义平台组，组名随后可用于任何接受组名的位置。
+// 成员列表本身可引用其他组，注册前先做一次解析校验。
+func RegisterGroup(name, spec string) error {
+	if strings.Contains(name, "/") || strings.Contains(name, ",") {
+		return fmt.Errorf(i18n.T("platform.invalid"), name)
+	}
+	if _, err := Parse(spec); err != nil {
+		return err
+	}
+	customGroups[name] = strings.Split(spec, ",")
+	return nil
+}
+
+// lookupGroup 查找组名，自定义组优先
+func lookupGroup(name string) ([]string, bool) {
+	if group, ok := customGroups[name]; ok {
+		return group, true
+	}
+	group, ok := PlatformGroups[name]
+	return group, ok
+}
+
+// Parse 解析 -p 参数：逗号分隔的组名或 os/arch 对，展开并去重
+func Parse(spec string) ([]Platform, error) {
+	seen := make(map[Platform]bool)
+	var result []Platform
+
+	add := func(pair string) error {
+		parts := strings.Split(pair, "/")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf(i18n.T("platform.invalid"), pair)
+		}
+		p := Platform{OS: parts[0], Arch: parts[1]}
+		if len(parts) == 3 {
+			if parts[2] == "" {
+				return fmt.Errorf(i18n.T("platform.invalid"), pair)
+			}
+			p.Variant = parts[2]
+			if key, _ := p.VariantEnv(); key == "" {
+				return fmt.Errorf(i18n.T("platform.variant_unsupported"), parts[2], parts[1])
+			}
+		}
+		if !seen[p] {
+			seen[p] = true
+			result = append(result, p)
+		}
+		return nil
+	}
+
+	// expand 递归展开组引用，自定义组的成员可以再引用组。
+	// done 里的组重复引用时直接去重跳过；真正的循环
+	// (组在自己的展开栈上再次出现) 用 expanding 单独识别报错
+	done := make(map[string]bool)
+	expanding := make(map[string]bool)
+	var expand func(item string) error
+	expand = func(item string) error {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			return nil
+		}
+		if group, ok := lookupGroup(item); ok {
+			if expanding[item] {
+				return fmt.Errorf(i18n.T("platform.group_cycle"), item)
+			}
+			if done[item] {
+				return nil
+			}
+			expanding[item] = true
+			for _, member := range group {
+				if err := expand(member); err != nil {
+					return err
+				}
+			}
+			delete(expanding, item)
+			done[item] = true
+			return nil
+		}
+		return add(item)
+	}
+
+	for _, item := range strings.Split(spec, ",") {
+		if err := expand(item); err != nil {
+			return nil, err
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf(i18n.T("platform.empty"))
+	}
+	return result, nil
+}
+
+// Host 返回当前宿主平台
+func Host(goos, goarch string) Platform {
+	return Platform{OS: goos, Arch: goarch}
+}
@@ -0,0 +1,76 @@
+package platform
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	ps, err := Parse("linux/amd64, linux/arm/v7,web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 4 {
+		t.Fatalf("期望 4 个平台, got %d: %v", len(ps), ps)
+	}
+	if ps[1].Variant != "v7" {
+		t.Errorf("变体解析错误: %+v", ps[1])
+	}
+	if key, value := ps[1].VariantEnv(); key != "GOARM" || value != "7" {
+		t.Errorf("VariantEnv = %s=%s", key, value)
+	}
+	if ps[1].ArchLabel() != "arm-v7" {
+		t.Errorf("ArchLabel = %s", ps[1].ArchLabel())
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, spec := range []string{"linux", "linux/", "/amd64", "", "linux/386/v3"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) 应当报错", spec)
+		}
+	}
+}
+
+func TestParseDedup(t *testing.T) {
+	ps, err := Parse("linux/amd64,container")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 2 {
+		t.Fatalf("组展开应去重: %v", ps)
+	}
+}
+
+func TestParseRepeatedGroup(t *testing.T) {
+	// 同一个组被引用多次只是去重，不是循环
+	ps, err := Parse("container,container")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 2 {
+		t.Fatalf("重复组引用应去重: %v", ps)
+	}
+}
+
+func TestParseGroupCycle(t *testing.T) {
+	customGroups["loop"] = []string{"linux/amd64", "loop"}
+	defer delete(customGroups, "loop")
+	if _, err := Parse("loop"); err == nil {
+		t.Error("组循环引用应报错")
+	}
+}
+
+func TestRegisterGroup(t *testing.T) {
+	defer delete(customGroups, "mine")
+	if err := RegisterGroup("mine", "container,linux/riscv64"); err != nil {
+		t.Fatal(err)
+	}
+	ps, err := Parse("mine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 3 {
+		t.Fatalf("自定义组应递归展开嵌套组: %v", ps)
+	}
+	if err := RegisterGroup("bad", "linux"); err == nil {
+		t.Error("非法成员应在注册时报错")
+	}
+}
@@ -0,0 +1,107 @@
+package platform
+
+import (
+	"encoding/json"
+	"os/exec"
+	"sync"
+)
+
+// PortTier Go 移植目标的支持等级
+type PortTier int
+
+const (
+	// TierUnknown 工具链信息不可用
+	TierUnknown PortTier = iota
+	// TierFirstClass Go 团队一级支持的端口
+	TierFirstClass
+	// TierSecondary 二级端口，可用但发布保障较弱
+	TierSecondary
+	// TierBroken 上游标记为损坏的端口
+	TierBroken
+)
+
+// String 返回等级的显示名
+func (t PortTier) String() string {
+	switch t {
+	case TierFirstClass:
+		return "first-class"
+	case TierSecondary:
+		return "secondary"
+	case TierBroken:
+		return "broken"
+	}
+	return "unknown"
+}
+
+// distPort go tool dist list -json 的单条记录
+type distPort struct {
+	GOOS       string `json:"GOOS"`
+	GOARCH     string `json:"GOARCH"`
+	FirstClass bool   `json:"FirstClass"`
+	Broken     bool   `json:"Broken,omitempty"`
+}
+
+var (
+	portsOnce sync.Once
+	portTiers map[string]PortTier
+)
+
+// portList 从 go tool dist list -json 读取各端口的支持等级，
+// 命令不可用时返回 nil
+func portList() map[string]PortTier {
+	portsOnce.Do(func() {
+		out, err := exec.Command("go", "tool", "dist", "list", "-json").Output()
+		if err != nil {
+			return
+		}
+		var ports []distPort
+		if err := json.Unmarshal(out, &ports); err != nil {
+			return
+		}
+		portTiers = make(map[string]PortTier, len(ports))
+		for _, port := range ports {
+			tier := TierSecondary
+			if port.Broken {
+				tier = TierBroken
+			} else if port.FirstClass {
+				tier = TierFirstClass
+			}
+			portTiers[port.GOOS+"/"+port.GOARCH] = tier
+		}
+	})
+	return portTiers
+}
+
+// Tier 返回平台的支持等级
+func (p Platform) Tier() PortTier {
+	tiers := portList()
+	if tiers == nil {
+		return TierUnknown
+	}
+	if tier, ok := tiers[p.OS+"/"+p.Arch]; ok {
+		return tier
+	}
+	return TierUnknown
+}
+
+// FilterByTier 按 --ports 过滤平台列表：
+// "first-class" 只留一级端口，"secondary" 留一级+二级 (剔除损坏端口)，
+// "all" 不过滤。返回保留的列表与被剔除的列表。
+func FilterByTier(ps []Platform, ports string) (kept, dropped []Platform) {
+	for _, p := range ps {
+		tier := p.Tier()
+		keep := true
+		switch ports {
+		case "first-class":
+			keep = tier == TierFirstClass
+		case "secondary":
+			keep = tier != TierBroken
+		}
+		if keep {
+			kept = append(kept, p)
+		} else {
+			dropped = append(dropped, p)
+		}
+	}
+	return kept, dropped
+}
@@ -0,0 +1,99 @@
+package platform
+
+// Set 保序的平台集合，用于 --include/--exclude/--only/--except 代数运算
+type Set struct {
+	ordered []Platform
+	seen    map[Platform]bool
+}
+
+// NewSet 从平台列表构造集合，保留首次出现顺序
+func NewSet(ps []Platform) *Set {
+	s := &Set{seen: make(map[Platform]bool)}
+	for _, p := range ps {
+		s.Add(p)
+	}
+	return s
+}
+
+// Add 加入平台，已存在时不改变顺序
+func (s *Set) Add(p Platform) {
+	if !s.seen[p] {
+		s.seen[p] = true
+		s.ordered = append(s.ordered, p)
+	}
+}
+
+// Remove 移除平台
+func (s *Set) Remove(p Platform) {
+	if s.seen[p] {
+		delete(s.seen, p)
+		for i, q := range s.ordered {
+			if q == p {
+				s.ordered = append(s.ordered[:i], s.ordered[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Contains 判断平台是否在集合中
+func (s *Set) Contains(p Platform) bool {
+	return s.seen[p]
+}
+
+// Platforms 返回保序的平台列表
+func (s *Set) Platforms() []Platform {
+	return s.ordered
+}
+
+// Algebra 平台集合的声明式运算：
+// 在基础列表上先用 Only 取交集，再并入 Include，
+// 最后减去 Exclude 与 Except。各项都接受组名与 os/arch 列表。
+type Algebra struct {
+	Include string
+	Exclude string
+	Only    string
+	Except  string
+}
+
+// Apply 把代数运算应用到基础平台列表，
+// 可表达 "desktop 去掉 windows/386 再加 linux/riscv64" 这类组合
+func (a Algebra) Apply(base []Platform) ([]Platform, error) {
+	set := NewSet(base)
+	if a.Only != "" {
+		keep, err := Parse(a.Only)
+		if err != nil {
+			return nil, err
+		}
+		keepSet := NewSet(keep)
+		// 复制一份再遍历，Remove 会原地修改底层切片
+		current := append([]Platform(nil), set.Platforms()...)
+		for _, p := range current {
+			if !keepSet.Contains(p) {
+				set.Remove(p)
+			}
+		}
+	}
+	if a.Include != "" {
+		extra, err := Parse(a.Include)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range extra {
+			set.Add(p)
+		}
+	}
+	for _, spec := range []string{a.Exclude, a.Except} {
+		if spec == "" {
+			continue
+		}
+		drop, err := Parse(spec)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range drop {
+			set.Remove(p)
+		}
+	}
+	return set.Platforms(), nil
+}
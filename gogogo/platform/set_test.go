@@ -0,0 +1,41 @@
+package platform
+
+import "testing"
+
+func TestAlgebraApply(t *testing.T) {
+	base, err := Parse("desktop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := Algebra{
+		Include: "linux/riscv64",
+		Exclude: "windows/386",
+	}.Apply(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := NewSet(ps)
+	if set.Contains(Platform{OS: "windows", Arch: "386"}) {
+		t.Error("--exclude 未移除 windows/386")
+	}
+	if !set.Contains(Platform{OS: "linux", Arch: "riscv64"}) {
+		t.Error("--include 未加入 linux/riscv64")
+	}
+	if len(ps) != len(base) {
+		t.Errorf("期望 %d 个平台, got %d", len(base), len(ps))
+	}
+}
+
+func TestAlgebraOnly(t *testing.T) {
+	base, err := Parse("desktop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := Algebra{Only: "linux/amd64,linux/arm64"}.Apply(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ps) != 2 {
+		t.Fatalf("--only 应只保留交集: %v", ps)
+	}
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// RootSolution 描述设备上的 root 实现及其目录布局，
+// Magisk / KernelSU / APatch 的 busybox 与 bin 路径各不相同
+type RootSolution struct {
+	// Name magisk / kernelsu / apatch
+	Name string
+	// BaseDir 实现自身的数据目录 (如 /data/adb/ksu)
+	BaseDir string
+	// BinDir 实现附带的工具目录，加入 PATH 可用 busybox 等
+	BinDir string
+	// Busybox busybox 可执行文件路径，不存在时为空
+	Busybox string
+}
+
+// rootSolutions 按检测优先级排列的已知 root 实现布局
+var rootSolutions = []RootSolution{
+	{
+		Name:    "kernelsu",
+		BaseDir: "/data/adb/ksu",
+		BinDir:  "/data/adb/ksu/bin",
+		Busybox: "/data/adb/ksu/bin/busybox",
+	},
+	{
+		Name:    "apatch",
+		BaseDir: "/data/adb/ap",
+		BinDir:  "/data/adb/ap/bin",
+		Busybox: "/data/adb/ap/bin/busybox",
+	},
+	{
+		Name:    "magisk",
+		BaseDir: "/data/adb/magisk",
+		BinDir:  "/data/adb/magisk",
+		Busybox: "/data/adb/magisk/busybox",
+	},
+}
+
+// DetectRootSolution 探测设备使用的 root 实现。
+// 三者的模块目录都是 /data/adb/modules，但工具路径不同；
+// 未检测到任何实现时返回 nil (非 root 或非 Android 环境)。
+func DetectRootSolution() *RootSolution {
+	for _, sol := range rootSolutions {
+		if info, err := os.Stat(sol.BaseDir); err == nil && info.IsDir() {
+			found := sol
+			if _, err := os.Stat(found.Busybox); err != nil {
+				found.Busybox = ""
+			}
+			return &found
+		}
+	}
+	return nil
+}
+
+// ModuleDir 返回 gogogo 模块的安装目录。
+// Magisk / KernelSU / APatch 共用 /data/adb/modules 布局。
+func ModuleDir() string {
+	dir := filepath.Join("/data/adb/modules", "gogogo")
+	if info, err := os.Stat(dir); err == nil && info.IsDir() {
+		return dir
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"sync"
+)
+
+// AssumeYes 由 --yes 设置，所有确认问题默认回答是
+var AssumeYes bool
+
+var (
+	answersOnce sync.Once
+	answers     map[string]string
+)
+
+// loadAnswers 解析 GOGOGO_ANSWERS 指向的 KEY=VALUE 应答文件
+func loadAnswers() {
+	answers = map[string]string{}
+	path := os.Getenv("GOGOGO_ANSWERS")
+	if path == "" {
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		answers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+}
+
+// PresetAnswer 查找问题 key 的预置回答：
+// 先查 GOGOGO_ANSWER_<KEY> 环境变量（点号转下划线、转大写），
+// 再查 GOGOGO_ANSWERS 应答文件
+func PresetAnswer(key string) (string, bool) {
+	envKey := "GOGOGO_ANSWER_" + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+	if v, ok := os.LookupEnv(envKey); ok {
+		return v, true
+	}
+	answersOnce.Do(loadAnswers)
+	v, ok := answers[key]
+	return v, ok
+}
+
+// Confirm 带 key 的确认问题：优先使用预置回答与 --yes，
+// 否则退回交互式询问
+func Confirm(key, prompt string) bool {
+	if v, ok := PresetAnswer(key); ok {
+		v = strings.ToLower(v)
+		return v == "y" || v == "yes" || v == "true" || v == "1"
+	}
+	if AssumeYes {
+		return true
+	}
+	return AskUserConfirm(prompt)
+}
+
+// Input 带 key 的文本输入：优先使用预置回答，否则交互式询问
+func Input(key, prompt, defaultValue string) string {
+	if v, ok := PresetAnswer(key); ok {
+		return v
+	}
+	if AssumeYes {
+		return defaultValue
+	}
+	return AskUserInput(prompt, defaultValue)
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// AskUserConfirm 询问用户确认 (y/n)
+func AskUserConfirm(prompt string) bool {
+	fmt.Printf("%s [y/N]: ", prompt)
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() {
+		return false
+	}
+	answer := strings.ToLower(strings.TrimSpace(scanner.Text()))
+	return answer == "y" || answer == "yes"
+}
+
+// AskUserInput 询问用户输入一行文本，为空时返回默认值
+func AskUserInput(prompt, defaultValue string) string {
+	if defaultValue != "" {
+		fmt.Printf("%s [%s]: ", prompt, defaultValue)
+	} else {
+		fmt.Printf("%s: ", prompt)
+	}
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() {
+		return defaultValue
+	}
+	answer := strings.TrimSpace(scanner.Text())
+	if answer == "" {
+		return defaultValue
+	}
+	return answer
+}
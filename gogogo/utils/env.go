@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// NDKTriple 返回 GOARCH 对应的 NDK 工具链三元组前缀
+func NDKTriple(goarch string) string {
+	switch goarch {
+	case "arm64":
+		return "aarch64-linux-android"
+	case "arm":
+		return "armv7a-linux-androideabi"
+	case "amd64":
+		return "x86_64-linux-android"
+	case "386":
+		return "i686-linux-android"
+	}
+	return ""
+}
+
+// SetupNDKEnvironment 为 android 目标追加 NDK 交叉编译环境变量，
+// apiLevel 选择对应 API 等级的 clang 包装器 (如 24 → aarch64-linux-android24-clang)
+func SetupNDKEnvironment(env []string, ndkPath, goarch string, apiLevel int) ([]string, error) {
+	triple := NDKTriple(goarch)
+	if triple == "" {
+		return nil, fmt.Errorf("不支持的 android 架构: %s", goarch)
+	}
+	hostTag := runtime.GOOS + "-x86_64"
+	bin := filepath.Join(ndkPath, "toolchains", "llvm", "prebuilt", hostTag, "bin")
+	cc := filepath.Join(bin, fmt.Sprintf("%s%d-clang", triple, apiLevel))
+	cxx := cc + "++"
+	if runtime.GOOS == "windows" {
+		cc += ".cmd"
+		cxx += ".cmd"
+	}
+	if _, err := os.Stat(cc); err != nil {
+		available := availableAPILevels(bin, triple)
+		if len(available) > 0 {
+			return nil, fmt.Errorf("该 NDK 不含 API %d 的编译器 (可用: %s)", apiLevel, strings.Join(available, ", "))
+		}
+		return nil, fmt.Errorf("NDK 编译器不存在: %s", cc)
+	}
+	env = append(env, "CC="+cc, "CXX="+cxx, "CGO_ENABLED=1")
+	return env, nil
+}
+
+// availableAPILevels 列出 NDK 工具链目录里该三元组实际提供的 API 等级
+func availableAPILevels(bin, triple string) []string {
+	matches, err := filepath.Glob(filepath.Join(bin, triple+"*-clang"))
+	if err != nil {
+		return nil
+	}
+	var levels []string
+	for _, m := range matches {
+		level := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(m), triple), "-clang")
+		if level != "" {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
+// PrintEnvironmentVars 打印构建环境变量，并对重复项给出警告
+func PrintEnvironmentVars(env []string) {
+	seen := make(map[string]string)
+	for _, kv := range env {
+		key, _, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		if prev, dup := seen[key]; dup && prev != kv {
+			fmt.Printf("  ⚠️  环境变量 %s 出现多次，后者生效\n", key)
+		}
+		seen[key] = kv
+	}
+	for _, key := range []string{"GOOS", "GOARCH", "CGO_ENABLED", "CC", "CXX"} {
+		if kv, ok := seen[key]; ok {
+			fmt.Printf("  %s\n", kv)
+		}
+	}
+}
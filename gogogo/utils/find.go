@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// FindNDK 查找 Android NDK 安装目录
+func FindNDK() string {
+	for _, env := range []string{"ANDROID_NDK_HOME", "ANDROID_NDK_ROOT", "NDK_HOME"} {
+		if dir := os.Getenv(env); dir != "" {
+			if _, err := os.Stat(dir); err == nil {
+				return dir
+			}
+		}
+	}
+	home, _ := os.UserHomeDir()
+	var candidates []string
+	switch runtime.GOOS {
+	case "windows":
+		candidates = []string{
+			filepath.Join(os.Getenv("LOCALAPPDATA"), "Android", "Sdk", "ndk"),
+		}
+	case "darwin":
+		candidates = []string{
+			filepath.Join(home, "Library", "Android", "sdk", "ndk"),
+		}
+	default:
+		candidates = []string{
+			filepath.Join(home, "Android", "Sdk", "ndk"),
+			"/opt/android-ndk",
+			"/usr/local/android-ndk",
+		}
+	}
+	for _, dir := range candidates {
+		entries, err := os.ReadDir(dir)
+		if err != nil || len(entries) == 0 {
+			continue
+		}
+		// ndk 目录下按版本号存放，取最后一个（最新）
+		return filepath.Join(dir, entries[len(entries)-1].Name())
+	}
+	return ""
+}
+
+// FindCompiler 在 PATH 中查找编译器
+func FindCompiler(name string) string {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// crossCompilers GOARCH 对应的交叉 gcc 候选名，按优先级排列：
+// 发行版的 gnu 工具链优先，musl-cross 其次 (静态链接场景常用)
+var crossCompilers = map[string][]string{
+	"arm64": {"aarch64-linux-gnu-gcc", "aarch64-linux-musl-gcc", "aarch64-unknown-linux-gnu-gcc"},
+	"arm":   {"arm-linux-gnueabihf-gcc", "arm-linux-gnueabi-gcc", "arm-linux-musleabihf-gcc"},
+	"amd64": {"x86_64-linux-gnu-gcc", "x86_64-linux-musl-gcc"},
+	"386":   {"i686-linux-gnu-gcc", "i686-linux-musl-gcc"},
+	"riscv64": {
+		"riscv64-linux-gnu-gcc", "riscv64-linux-musl-gcc",
+	},
+	"ppc64le": {"powerpc64le-linux-gnu-gcc"},
+	"s390x":   {"s390x-linux-gnu-gcc"},
+	"mips64le": {
+		"mips64el-linux-gnuabi64-gcc",
+	},
+	"mipsle": {"mipsel-linux-gnu-gcc"},
+	"mips":   {"mips-linux-gnu-gcc"},
+}
+
+// FindMuslGCC 查找 musl 交叉工具链 (--static 优先使用，
+// glibc 的静态链接对 NSS 等有坑)，找不到返回空串
+func FindMuslGCC(goarch string) string {
+	for _, name := range crossCompilers[goarch] {
+		if strings.Contains(name, "musl") {
+			if path := FindCompiler(name); path != "" {
+				return path
+			}
+		}
+	}
+	// 宿主架构时还可以试本机 musl-gcc 包装器
+	if runtime.GOOS == "linux" && runtime.GOARCH == goarch {
+		return FindCompiler("musl-gcc")
+	}
+	return ""
+}
+
+// FindCrossGCC 为 linux/<goarch> 目标查找可用的交叉 gcc，
+// 宿主即目标架构时直接用系统 cc，找不到返回空串
+func FindCrossGCC(goarch string) string {
+	if runtime.GOOS == "linux" && runtime.GOARCH == goarch {
+		if cc := FindCompiler("cc"); cc != "" {
+			return cc
+		}
+		return FindCompiler("gcc")
+	}
+	for _, name := range crossCompilers[goarch] {
+		if path := FindCompiler(name); path != "" {
+			return path
+		}
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// iosMinVersion 生成产物支持的最低 iOS 版本
+const iosMinVersion = "12.0"
+
+// iosSDKName 根据架构区分设备与模拟器 SDK：
+// arm64 为真机 (iphoneos)，amd64 只存在于模拟器 (iphonesimulator)
+func iosSDKName(goarch string) string {
+	if goarch == "amd64" {
+		return "iphonesimulator"
+	}
+	return "iphoneos"
+}
+
+// SetupIOSEnvironment 为 ios 目标追加 SDKROOT 与携带
+// -isysroot / 最低版本参数的 CC，仅在 macOS 宿主上可用。
+// 已设置 SDKROOT 时沿用用户的值。
+func SetupIOSEnvironment(env []string, goarch string) ([]string, error) {
+	if runtime.GOOS != "darwin" {
+		return nil, fmt.Errorf("ios 目标的 CGO 构建只能在 macOS 上进行 (当前 %s)", runtime.GOOS)
+	}
+	sdk := iosSDKName(goarch)
+	sdkRoot := os.Getenv("SDKROOT")
+	if sdkRoot == "" {
+		out, err := exec.Command("xcrun", "--sdk", sdk, "--show-sdk-path").Output()
+		if err != nil {
+			return nil, fmt.Errorf("未找到 %s SDK，请安装 Xcode 并运行 xcode-select --install", sdk)
+		}
+		sdkRoot = strings.TrimSpace(string(out))
+	}
+	out, err := exec.Command("xcrun", "--sdk", sdk, "--find", "clang").Output()
+	if err != nil {
+		return nil, fmt.Errorf("xcrun 未找到 %s 的 clang: %w", sdk, err)
+	}
+	clang := strings.TrimSpace(string(out))
+
+	minFlag := "-miphoneos-version-min=" + iosMinVersion
+	if sdk == "iphonesimulator" {
+		minFlag = "-mios-simulator-version-min=" + iosMinVersion
+	}
+	flags := fmt.Sprintf("-isysroot %s %s", sdkRoot, minFlag)
+	env = append(env,
+		"SDKROOT="+sdkRoot,
+		"CC="+clang+" "+flags,
+		"CXX="+clang+"++ "+flags,
+		"CGO_ENABLED=1")
+	return env, nil
+}
@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// Logger 机器可读的构建日志，默认丢弃；
+// 通过 --log-file 挂载第二个 slog handler 后开始记录，
+// 与控制台彩色输出的 verbosity 互不影响
+var Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// SetupLogFile 打开日志文件并按 format (text/json) 挂载 handler，
+// 返回关闭函数
+func SetupLogFile(path, format string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("无法打开日志文件 %s: %w", path, err)
+	}
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	var handler slog.Handler
+	switch format {
+	case "json":
+		handler = slog.NewJSONHandler(f, opts)
+	case "text", "":
+		handler = slog.NewTextHandler(f, opts)
+	default:
+		f.Close()
+		return nil, fmt.Errorf("未知的日志格式: %q (支持 text/json)", format)
+	}
+	Logger = slog.New(handler)
+	return func() { f.Close() }, nil
+}
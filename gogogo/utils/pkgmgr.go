@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// PackageManager 一种系统包管理器及其安装命令。
+// 自动安装逻辑源自旧版 MyModule 变体，合并后收拢到这里
+type PackageManager struct {
+	// Name 可执行文件名，也用于探测
+	Name string
+	// InstallArgs 安装命令的前缀，包名追加在末尾
+	InstallArgs []string
+	// NeedsSudo 非 root 时是否要加 sudo (系统级包管理器)
+	NeedsSudo bool
+	// OS 适用的 GOOS 列表
+	OS []string
+}
+
+// packageManagers 按探测优先级排列：
+// 用户级的 (scoop/brew) 排在系统级的前面，装了哪个就用哪个
+var packageManagers = []PackageManager{
+	{Name: "scoop", InstallArgs: []string{"scoop", "install"}, OS: []string{"windows"}},
+	{Name: "winget", InstallArgs: []string{"winget", "install", "-e", "--id"}, OS: []string{"windows"}},
+	{Name: "choco", InstallArgs: []string{"choco", "install", "-y"}, OS: []string{"windows"}},
+	{Name: "brew", InstallArgs: []string{"brew", "install"}, OS: []string{"darwin", "linux"}},
+	{Name: "apt", InstallArgs: []string{"apt", "install", "-y"}, NeedsSudo: true, OS: []string{"linux"}},
+	{Name: "dnf", InstallArgs: []string{"dnf", "install", "-y"}, NeedsSudo: true, OS: []string{"linux"}},
+	{Name: "pacman", InstallArgs: []string{"pacman", "-S", "--noconfirm"}, NeedsSudo: true, OS: []string{"linux"}},
+}
+
+// toolchainPackages 工具链在各包管理器下的包名，缺省用工具名本身
+var toolchainPackages = map[string]map[string]string{
+	"clang": {
+		"scoop":  "llvm",
+		"winget": "LLVM.LLVM",
+		"choco":  "llvm",
+		"brew":   "llvm",
+	},
+}
+
+// DetectPackageManager 按优先级探测当前系统可用的包管理器
+func DetectPackageManager() (*PackageManager, error) {
+	var tried []string
+	for i := range packageManagers {
+		m := &packageManagers[i]
+		applicable := false
+		for _, goos := range m.OS {
+			if goos == runtime.GOOS {
+				applicable = true
+			}
+		}
+		if !applicable {
+			continue
+		}
+		tried = append(tried, m.Name)
+		if _, err := exec.LookPath(m.Name); err == nil {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("未找到可用的包管理器 (尝试了 %s)", strings.Join(tried, "/"))
+}
+
+// ToolchainPackage 工具链在该包管理器下的包名
+func (m *PackageManager) ToolchainPackage(tool string) string {
+	if pkg, ok := toolchainPackages[tool][m.Name]; ok {
+		return pkg
+	}
+	return tool
+}
+
+// InstallPackage 执行安装命令，输出直通终端
+func (m *PackageManager) InstallPackage(pkg string) error {
+	args := append(append([]string{}, m.InstallArgs...), pkg)
+	if m.NeedsSudo && os.Geteuid() != 0 {
+		args = append([]string{"sudo"}, args...)
+	}
+	fmt.Printf("📦 执行: %s\n", strings.Join(args, " "))
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s 安装失败: %v", m.Name, err)
+	}
+	return nil
+}